@@ -8,100 +8,95 @@ import (
 	"log"
 	"math/rand"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/LocatedInSpace/Distributed-Auction-System/dasclient"
+	"github.com/LocatedInSpace/Distributed-Auction-System/discovery"
 	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
-
-	"google.golang.org/grpc"
 )
 
+// everything talking to the replicas is in the dasclient package, this is just the command line around it
+
+// defaults for the settings in config.go, which can be changed without editing these
 const BASEPORT = 7000 // port offset to look for servers from
-const REPLICAS = 4    // amount of replicas we've started up
+const REPLICAS = 4    // amount of replicas in the cluster, a majority of them has to answer every request
 
 const VERBOSE = true          // print each response from each replica
 const PRECISE_LOGGING = false // ups precision on timestamps
 
+const DISCOVERY = "static"          // how replicas are found, static is the peers - file is the replicas registered in MEMBERS_FILE
+const MEMBERS_FILE = "members.json" // membership file replicas register in
+
 const AUTOCLIENT = false // will randomly call startauction, sendbids, etc.
 // this parameter was used to generate the logs that verify replicas are in sync
 
 const MIN_DELAY = 20  // mindelay before next AUTOCLIENT roll
 const MAX_DELAY = 100 // maxdelay before next AUTOCLIENT roll
-type ReplicaServers struct {
-	clients []DAS.DASClient
-	ctx     context.Context
-}
 
-var clientToPort map[DAS.DASClient]int32
+const LATENCY_REPORT_EVERY = 100 // AUTOCLIENT rolls between each time the latency of every replica is printed
 
-var id uint32
+// id of the auction last started, bid on or looked up - used when a command leaves out the id
+var auction string
 
-func main() {
-	idUint64, err := strconv.ParseUint(os.Args[1], 10, 32)
-	if err != nil || idUint64 == 0 {
-		log.Fatalf("You need to supply a valid uint32 value > 0")
-	}
-	id = uint32(idUint64)
+// the latest outcome pushed for each auction we are watching, so bidding does not have to ask for the price
+var watched = make(map[string]*DAS.Outcome)
+var watchedMutex sync.Mutex
 
-	clientToPort = make(map[DAS.DASClient]int32)
+func main() {
+	cfg := LoadConfig()
+	id := cfg.ID
 
-	f := setLog(id)
+	f := setLog(id, cfg.PreciseLogging)
 	defer f.Close()
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	server := &ReplicaServers{
-		ctx: ctx,
+	options := []dasclient.Option{dasclient.WithReplicas(cfg.Peers...)}
+	if cfg.Discovery == "file" {
+		options = []dasclient.Option{dasclient.WithResolver(discovery.File{Path: cfg.MembersFile})}
 	}
-
-	var allReplicasDead bool = true
-
-	// connect to all replicas
-	for i := 0; i < REPLICAS; i++ {
-		port := BASEPORT + int32(i)
-
-		var conn *grpc.ClientConn
-		// 1 second timeout
-		ctx, _ := context.WithTimeout(context.Background(), 1*time.Second)
-		conn, err := grpc.DialContext(ctx, fmt.Sprintf(":%v", port), grpc.WithInsecure(), grpc.WithBlock())
-		if err != nil {
-			log.Printf("Dial (port %v) failed: %s", port, err)
-			continue
-		} else {
-			log.Printf("Dial (port: %v) succeeded\n", port)
-		}
-		defer conn.Close()
-
-		allReplicasDead = false
-		c := DAS.NewDASClient(conn)
-		server.clients = append(server.clients, c)
-		clientToPort[c] = port
+	if cfg.Verbose {
+		options = append(options, dasclient.WithLogger(log.Default()))
 	}
-	if allReplicasDead {
-		log.Fatalf("Could not find any replicas - are you sure they are running?")
+	server, err := dasclient.New(id, options...)
+	if err != nil {
+		log.Fatalf("%v", err)
 	}
+	defer server.Close()
 
-	if AUTOCLIENT {
+	if cfg.Autoclient {
 		rand.Seed(time.Now().UnixNano())
 		item := fmt.Sprintf("item-%v", id)
 		var action int
-		for {
+		for roll := 1; ; roll++ {
 			action = rand.Intn(10)
-			server.PurgeDeadReplicas()
+			server.Purge(ctx)
+			if roll%LATENCY_REPORT_EVERY == 0 {
+				ReportLatency(server)
+			}
 			switch action {
 			case 0:
-				log.Println(server.SendBid(server.GetResults().Amount + 1))
+				if outcome, err := server.Result(ctx, auction); err == nil {
+					LogAck(server.Bid(ctx, auction, outcome.Amount+1))
+				}
 			case 1:
-				server.SendBid(rand.Uint64())
+				server.Bid(ctx, auction, rand.Uint64())
 			case 2:
-				log.Println(FormatOutcome(server.GetResults()))
+				LogOutcome(server.Result(ctx, auction))
 			case 3:
-				log.Println(server.StartAuction(rand.Uint64(), uint32(rand.Intn(65535)), item))
+				ack, err := server.StartAuction(ctx, rand.Uint64(), uint32(rand.Intn(65535)), item)
+				if err == nil && ack.Auction != "" {
+					auction = ack.Auction
+				}
+				LogAck(ack, err)
 			}
 			// 1e6 = millisecond
-			time.Sleep(time.Duration((MIN_DELAY + rand.Intn(MAX_DELAY-MIN_DELAY)) * 1e6))
+			time.Sleep(time.Duration((cfg.MinDelay + rand.Intn(cfg.MaxDelay-cfg.MinDelay)) * 1e6))
 		}
 	} else {
 		reader := bufio.NewReader(os.Stdin)
@@ -116,35 +111,172 @@ func main() {
 
 			input := strings.Fields(text)
 
-			server.PurgeDeadReplicas()
+			server.Purge(ctx)
 			if input[0] == "h" {
 				fmt.Println(`| 'h' displays commands & their syntax
-| 'b *amount' bids on auction, with * being a number
+| 'b *id *amount' bids on auction with id, with amount being a number
+|     if id is empty, then we bid on the auction we last started, bid on or looked up
 |     if amount is empty, then we assume that we want to increment bid by 1
-| 'r' gets the result of the active (or last) auction
-| 's *start *duration *name' starts an auction lasting duration, for item with name, & starting bid`)
+| 'p *id max' has the leader bid for us on auction with id whenever we are outbid, up to max
+| 'c *id amount' commits to a bid of amount on the sealed auction with id, without sending the bid
+| 'e *id' reveals the bid we committed to on the auction with id, once it is over - if id is empty, the one we last committed to
+| 'r *id' gets the result of the auction with id, if id is empty - the one we last used
+| 'v *id' lists every bid placed on the auction with id, the ones turned down too
+| 'g id' gets the auction with id, whether it is over or not
+| 'o *filter *within' lists every auction started within the duration (like 1h30m), all of them if it is empty
+|     filter is one of all, live, closed, unsold or sold - all if it is empty
+| 'w *id' watches the auction with id, printing every bid & the time left until it closes
+| 'l' shows how fast each replica has been answering
+| 'm' shows every replica in the cluster, & the membership epoch
+| 'a' shows which replicas each replica believes are alive
+| 'j addr' adds the replica listening on addr to the cluster, 'x addr' removes it
+| 's *start *duration *options *name' starts an auction lasting duration, for item with name, & starting bid
+|     options go before the name, & are any of
+|     'extend=within:by' a bid within the last within (like 5s) of the auction, extends it by by
+|     'increment=amount' or 'increment=percent%' a bid has to be this much higher than the highest bid
+|     'reserve=price' the item is not sold for less than price, which is kept from the bidders
+|     'format=first_price' or 'format=second_price' bids are sealed until the auction is over, & the highest bid
+|     pays what it bid - or the second highest bid
+|     'format=dutch' & 'drop=amount:every' the price starts at the starting bid, & drops by amount every every
+|     (like 1s) down to the reserve - the first bid at the price wins
+|     'buynow=price' the first bid of at least price wins the auction outright, & pays price
+|     'reveal=duration' a sealed auction takes commitments instead of bids, which are revealed for duration once it is over`)
 			} else if input[0] == "b" {
-				if len(input) == 1 {
-					// get highest bid - add one
-					log.Println(server.SendBid(server.GetResults().Amount + 1))
-				} else {
-					bid, err := strconv.ParseUint(input[1], 10, 64)
-					if err != nil {
-						fmt.Println("The second parameter of 'b' MUST be a uint64")
-						continue
+				var bid uint64
+				for _, arg := range input[1:] {
+					// auction ids are uuids, so anything that is a number must be the amount
+					if amount, err := strconv.ParseUint(arg, 10, 64); err == nil {
+						bid = amount
+					} else {
+						auction = arg
+					}
+				}
+				if bid == 0 {
+					// get highest bid - add one, we already know it if we are watching the auction
+					watchedMutex.Lock()
+					outcome, ok := watched[auction]
+					watchedMutex.Unlock()
+					if !ok || auction == "" {
+						outcome, err = server.Result(ctx, auction)
+						if err != nil {
+							log.Printf("| %v\n", err)
+							continue
+						}
+					}
+					bid = outcome.Minimum
+					if bid == 0 {
+						bid = outcome.Amount + 1
 					}
-					log.Println(server.SendBid(bid))
 				}
+				LogAck(server.Bid(ctx, auction, bid))
+			} else if input[0] == "p" {
+				var max uint64
+				for _, arg := range input[1:] {
+					if amount, err := strconv.ParseUint(arg, 10, 64); err == nil {
+						max = amount
+					} else {
+						auction = arg
+					}
+				}
+				if max == 0 {
+					fmt.Println("Missing the maximum bid")
+					continue
+				}
+				LogAck(server.SetMaxBid(ctx, auction, max))
+			} else if input[0] == "c" {
+				var bid uint64
+				for _, arg := range input[1:] {
+					if amount, err := strconv.ParseUint(arg, 10, 64); err == nil {
+						bid = amount
+					} else {
+						auction = arg
+					}
+				}
+				if bid == 0 {
+					fmt.Println("Missing the bid to commit to")
+					continue
+				}
+				LogAck(server.CommitBid(ctx, auction, bid))
+			} else if input[0] == "e" {
+				id := ""
+				if len(input) > 1 {
+					id = input[1]
+				}
+				LogAck(server.RevealBid(ctx, id))
 			} else if input[0] == "r" {
-				log.Println(FormatOutcome(server.GetResults()))
+				if len(input) > 1 {
+					auction = input[1]
+				}
+				LogOutcome(server.Result(ctx, auction))
+			} else if input[0] == "v" {
+				if len(input) > 1 {
+					auction = input[1]
+				}
+				ReportBids(ctx, server, auction)
+			} else if input[0] == "g" {
+				if len(input) < 2 {
+					fmt.Println("Missing the id of the auction")
+					continue
+				}
+				auction = input[1]
+				LogOutcome(server.GetAuction(ctx, auction))
+			} else if input[0] == "o" {
+				query := &DAS.AuctionsQuery{}
+				for _, arg := range input[1:] {
+					if filter, ok := DAS.Filter_value[strings.ToUpper(arg)]; ok {
+						query.Filter = DAS.Filter(filter)
+					} else if within, err := time.ParseDuration(arg); err == nil {
+						query.Since = time.Now().Add(-within).UnixMilli()
+					} else {
+						fmt.Println("Parameters of 'o' MUST be a filter or a duration")
+						query = nil
+						break
+					}
+				}
+				if query != nil {
+					ReportAuctions(ctx, server, query)
+				}
+			} else if input[0] == "l" {
+				ReportLatency(server)
+			} else if input[0] == "a" {
+				ReportHealth(server.Health(ctx))
+			} else if input[0] == "m" {
+				membership, err := server.Members(ctx)
+				if err != nil {
+					log.Printf("| %v\n", err)
+					continue
+				}
+				log.Printf("| Membership epoch %v, %v replicas: %s\n", membership.Epoch, len(membership.Members), strings.Join(membership.Members, ", "))
+			} else if input[0] == "j" || input[0] == "x" {
+				if len(input) < 2 {
+					fmt.Println("Missing the address of the replica")
+					continue
+				}
+				if input[0] == "j" {
+					LogAck(server.JoinCluster(ctx, input[1]))
+				} else {
+					LogAck(server.LeaveCluster(ctx, input[1]))
+				}
+			} else if input[0] == "w" {
+				if len(input) > 1 {
+					auction = input[1]
+				}
+				go Watch(ctx, server, auction)
 			} else if input[0] == "s" {
-				name := ""
 				if len(input) < 4 {
 					fmt.Println("Missing parameters - 4 are expected")
 					continue
-				} else {
-					name = strings.Join(input[3:], " ")
 				}
+				opts, rest, err := ParseAuctionOptions(input[3:])
+				if err != nil {
+					fmt.Println(err)
+					continue
+				}
+				if len(rest) == 0 {
+					fmt.Println("Missing the name of the item")
+					continue
+				}
+				name := strings.Join(rest, " ")
 
 				start, err := strconv.ParseUint(input[1], 10, 64)
 				if err != nil {
@@ -157,7 +289,13 @@ func main() {
 					fmt.Println("The third parameter of 's' MUST be a uint32")
 					continue
 				}
-				log.Println(server.StartAuction(start, uint32(duration), name))
+				ack, err := server.StartAuction(ctx, start, uint32(duration), name, opts...)
+				if err == nil && ack.Auction != "" {
+					auction = ack.Auction
+					log.Printf("| Started auction '%s' for '%s'\n", ack.Auction, name)
+				} else {
+					LogAck(ack, err)
+				}
 			} else {
 				fmt.Println("Command not recognized :(")
 			}
@@ -165,173 +303,283 @@ func main() {
 	}
 }
 
-func (s *ReplicaServers) PurgeDeadReplicas() {
-	// why is this needed? since we are removing old replicas in sendbid & startauction - lets imagine the following situation:
-	// client A has called result - finds out some servers are dead
-	// then removes them from own replicaservers
-	// now client B (who still has an old replicaserver) tries to start an auction
-	// client B will timeout on the dead servers for one second - in one of these timeouts, client A starts an auction but will incur no timeouts
-	// the servers are now out of sync - client A will reach the later servers, before client B, even though client B called first
-
-	// ok - so why not NOT purge servers then? while this *can* be fine, there is a chance of the following situation:
-	// client A has a dead replica in replicaservers, the second to last one. there is one second left in the auction
-	// client A sends a bid, replicaserver 1..N-2 all accept it - replicaserver N-1 is timed out, client A waits a second here
-	// replicaserver N declines client A bid, since it is after the auction has ended.
-
-	// passive replication would definitely be a better solution for auction, since we only have to worry about central replica time then
-	// and technically since the servers will be a few milliseconds out of sync - there is the *slightest* chance of above situation happening without
-	// timeouts - even though it is incredibly slim (given the assignment assumption of transmissions happening with a known time-limit, this is combated by using the servers local time as timestamp, however
-	// this is only a theoretically correct implementatiom in practice, there will be differences in how long a transmission takes on the same network under the same conditions)
-	query := &DAS.Empty{}
-
-	var remove []int
-	for i, r := range s.clients {
-		_, err := r.Ping(s.ctx, query)
-		if err != nil {
-			remove = append(remove, i)
-			continue
+// returns the options at the start of args, & the args after them
+func ParseAuctionOptions(args []string) ([]dasclient.AuctionOption, []string, error) {
+	var opts []dasclient.AuctionOption
+	for len(args) > 0 {
+		key, value, ok := strings.Cut(args[0], "=")
+		if !ok {
+			break
+		}
+		switch key {
+		case "extend":
+			within, by, _ := strings.Cut(value, ":")
+			withinDuration, err1 := time.ParseDuration(within)
+			byDuration, err2 := time.ParseDuration(by)
+			if err1 != nil || err2 != nil {
+				return nil, nil, fmt.Errorf("The option extend MUST be two durations, like extend=5s:10s")
+			}
+			opts = append(opts, dasclient.WithSoftClose(withinDuration, byDuration))
+		case "increment":
+			if strings.HasSuffix(value, "%") {
+				pct, err := strconv.ParseUint(strings.TrimSuffix(value, "%"), 10, 32)
+				if err != nil {
+					return nil, nil, fmt.Errorf("The option increment MUST be a number or a percentage, like increment=5%%")
+				}
+				opts = append(opts, dasclient.WithIncrement(0, uint32(pct)))
+			} else {
+				increment, err := strconv.ParseUint(value, 10, 64)
+				if err != nil {
+					return nil, nil, fmt.Errorf("The option increment MUST be a number or a percentage, like increment=5%%")
+				}
+				opts = append(opts, dasclient.WithIncrement(increment, 0))
+			}
+		case "reserve":
+			reserve, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, nil, fmt.Errorf("The option reserve MUST be a uint64")
+			}
+			opts = append(opts, dasclient.WithReserve(reserve))
+		case "drop":
+			amount, every, _ := strings.Cut(value, ":")
+			drop, err1 := strconv.ParseUint(amount, 10, 64)
+			everyDuration, err2 := time.ParseDuration(every)
+			if err1 != nil || err2 != nil {
+				return nil, nil, fmt.Errorf("The option drop MUST be a number & a duration, like drop=5:1s")
+			}
+			opts = append(opts, dasclient.WithDrop(drop, everyDuration))
+		case "buynow":
+			price, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, nil, fmt.Errorf("The option buynow MUST be a uint64")
+			}
+			opts = append(opts, dasclient.WithBuyNow(price))
+		case "reveal":
+			reveal, err := time.ParseDuration(value)
+			if err != nil {
+				return nil, nil, fmt.Errorf("The option reveal MUST be a duration, like reveal=10s")
+			}
+			opts = append(opts, dasclient.WithReveal(reveal))
+		case "format":
+			format, ok := DAS.Format_value[strings.ToUpper(value)]
+			if !ok {
+				return nil, nil, fmt.Errorf("The option format MUST be open, first_price, second_price or dutch")
+			}
+			opts = append(opts, dasclient.WithFormat(DAS.Format(format)))
+		default:
+			return nil, nil, fmt.Errorf("Option '%v' not recognized", key)
 		}
+		args = args[1:]
 	}
+	return opts, args, nil
+}
 
-	for i, val := range remove {
-		// remove from ReplicaServers clients these indexes - since calling them failed
-		s.clients = append(s.clients[:val-i], s.clients[(val-i)+1:]...)
+// prints every event pushed for an auction, until it closes
+func Watch(ctx context.Context, server *dasclient.Client, auction string) {
+	err := server.Watch(ctx, auction, func(event *DAS.Event) {
+		watchedMutex.Lock()
+		if event.Kind == DAS.Events_CLOSE {
+			delete(watched, event.Outcome.Auction)
+		} else {
+			watched[event.Outcome.Auction] = event.Outcome
+		}
+		watchedMutex.Unlock()
+		log.Println(FormatEvent(event))
+	})
+	if err != nil {
+		log.Printf("| %v\n", err)
 	}
 }
 
-func (s *ReplicaServers) SendBid(amount uint64) *DAS.Ack {
-	query := &DAS.Amount{
-		Id:  id,
-		Bid: amount,
+// prints every bid placed on an auction, a page at a time
+func ReportBids(ctx context.Context, server *dasclient.Client, auction string) {
+	token := ""
+	for {
+		page, err := server.ListBids(ctx, auction, 0, token)
+		if err != nil {
+			log.Printf("| %v\n", err)
+			return
+		}
+		if page.Total == 0 {
+			log.Printf("| Auction '%s' has no bids\n", page.Auction)
+		}
+		for _, bid := range page.Bids {
+			result := "accepted"
+			if !bid.Accepted {
+				result = fmt.Sprintf("turned down (%v)", bid.Reason)
+			}
+			if bid.Proxy {
+				result += ", up to their maximum bid"
+			}
+			when := time.UnixMilli(bid.Time).Format("15:04:05.000")
+			if len(bid.Commitment) > 0 {
+				short := bid.Commitment
+				if len(short) > 8 {
+					short = short[:8]
+				}
+				log.Printf("| %v | id %v committed to a bid (%x), %v\n", when, bid.Bidder, short, result)
+				continue
+			} else if bid.Reveal && bid.Amount == 0 {
+				log.Printf("| %v | id %v revealed a bid, %v\n", when, bid.Bidder, result)
+				continue
+			} else if bid.Reveal {
+				log.Printf("| %v | id %v revealed a bid of %v, %v\n", when, bid.Bidder, bid.Amount, result)
+				continue
+			} else if bid.Maximum {
+				log.Printf("| %v | id %v set a maximum bid, %v\n", when, bid.Bidder, result)
+				continue
+			}
+			// amounts of a sealed auction are only sent once it is over
+			if bid.Amount == 0 {
+				log.Printf("| %v | id %v placed a sealed bid, %v\n", when, bid.Bidder, result)
+				continue
+			}
+			log.Printf("| %v | id %v bid %v, %v\n", when, bid.Bidder, bid.Amount, result)
+		}
+		if page.NextPageToken == "" {
+			return
+		}
+		token = page.NextPageToken
 	}
+}
 
-	var responses []*DAS.Ack
-	var remove []int
-	if VERBOSE {
-		log.Println("--- SendBid queried ---")
-	}
-	for i, r := range s.clients {
-		ack, err := r.Bid(s.ctx, query)
+// prints every auction a query asks for, a page at a time
+func ReportAuctions(ctx context.Context, server *dasclient.Client, query *DAS.AuctionsQuery) {
+	found := 0
+	for {
+		page, err := server.ListAuctions(ctx, query)
 		if err != nil {
-			remove = append(remove, i)
-			if VERBOSE {
-				log.Printf("Port %v | %s\n", clientToPort[r], err)
-			}
-			continue
+			log.Printf("| %v\n", err)
+			return
+		}
+		for _, outcome := range page.Auctions {
+			log.Println(FormatOutcome(outcome))
 		}
-		if VERBOSE {
-			log.Printf("Port %v | %s\n", clientToPort[r], ack)
+		found += len(page.Auctions)
+		if page.NextPageToken == "" {
+			break
 		}
-		responses = append(responses, ack)
+		query.PageToken = page.NextPageToken
 	}
-	if VERBOSE {
-		log.Println("---------------------")
+	if found == 0 {
+		log.Printf("| There are no %v auctions\n", strings.ToLower(query.Filter.String()))
 	}
+}
 
-	for i, val := range remove {
-		// remove from ReplicaServers clients these indexes - since calling them failed
-		s.clients = append(s.clients[:val-i], s.clients[(val-i)+1:]...)
+// prints how fast each replica has answered, slowest on average first
+func ReportLatency(server *dasclient.Client) {
+	latency := server.Latency()
+	sort.Slice(latency, func(i, j int) bool {
+		return latency[i].Average() > latency[j].Average()
+	})
+	if len(latency) == 0 {
+		log.Println("| No replica has answered yet")
+	}
+	for _, l := range latency {
+		log.Printf("| %v | %v calls, %v timed out, %v cancelled | avg %vms, max %vms, last %vms\n", l.Addr, l.Calls, l.Timeouts, l.Cancelled,
+			l.Average().Milliseconds(), l.Max.Milliseconds(), l.Last.Milliseconds())
 	}
-
-	return responses[0]
 }
 
-func FormatOutcome(outcome *DAS.Outcome) string {
-	r := fmt.Sprintf("%v", outcome)
-	if len(r) == 0 {
-		r = "There is no active auction"
-	} else {
-		if outcome.Left > 0 {
-			if outcome.Bidder != 0 {
-				r = fmt.Sprintf("| Auction for '%s' has %vms left, highest bid (by id %v) is %v", outcome.Item, outcome.Left, outcome.Bidder, outcome.Amount)
-			} else {
-				r = fmt.Sprintf("| Auction for '%s' has %vms left, starting bid is %v", outcome.Item, outcome.Left, outcome.Amount)
+// prints which peers each replica believes are alive, & how suspicious it is of the ones it is not sure about
+func ReportHealth(reports []*DAS.HealthReport) {
+	if len(reports) == 0 {
+		log.Printf("| No replica answered\n")
+	}
+	for _, report := range reports {
+		var peers []string
+		for _, peer := range report.Peers {
+			state := "alive"
+			if !peer.Alive {
+				state = "dead"
 			}
-		} else {
-			if outcome.Bidder != 0 {
-				r = fmt.Sprintf("| Auction for '%s' was won (by id %v) for %v", outcome.Item, outcome.Bidder, outcome.Amount)
+			if peer.LastSeen < 0 {
+				peers = append(peers, fmt.Sprintf("%v %v (never answered)", peer.Addr, state))
 			} else {
-				r = fmt.Sprintf("| Auction for '%s' did not sell, starting bid was %v", outcome.Item, outcome.Amount)
+				peers = append(peers, fmt.Sprintf("%v %v (phi %.1f, %vms ago)", peer.Addr, state, peer.Phi, peer.LastSeen))
 			}
 		}
+		majority := "has a majority"
+		if !report.Majority {
+			majority = "has NO majority"
+		}
+		log.Printf("| %v, term %v, leader '%v', %v: %s\n", report.Addr, report.Term, report.Leader, majority, strings.Join(peers, ", "))
 	}
-	return r
 }
 
-func (s *ReplicaServers) GetResults() *DAS.Outcome {
-	query := &DAS.Empty{}
-
-	var responses []*DAS.Outcome
-	var remove []int
-	if VERBOSE {
-		log.Println("--- GetResults queried ---")
-	}
-	for i, r := range s.clients {
-		outcome, err := r.Result(s.ctx, query)
-		if err != nil {
-			remove = append(remove, i)
-			if VERBOSE {
-				log.Printf("Port %v | %s\n", clientToPort[r], err)
-			}
-			continue
-		}
-		if VERBOSE {
-			log.Printf("Port %v | %s\n", clientToPort[r], outcome)
-		}
-		responses = append(responses, outcome)
-	}
-	if VERBOSE {
-		log.Println("---------------------")
+// prints what a write was answered with, or why it was not answered
+func LogAck(ack *DAS.Ack, err error) {
+	if err != nil {
+		log.Printf("| %v\n", err)
+		return
 	}
-
-	for i, val := range remove {
-		// remove from ReplicaServers clients these indexes - since calling them failed
-		s.clients = append(s.clients[:val-i], s.clients[(val-i)+1:]...)
+	if ack.Won {
+		log.Printf("| You won auction '%s' outright, paying %v - it is over\n", ack.Auction, ack.Highest)
+		return
 	}
-
-	return responses[0]
+	log.Println(ack)
 }
 
-func (s *ReplicaServers) StartAuction(start uint64, duration uint32, name string) *DAS.Ack {
-	query := &DAS.Item{
-		Name:  name,
-		Start: start,
-		Alive: duration,
+func LogOutcome(outcome *DAS.Outcome, err error) {
+	if err != nil {
+		log.Printf("| %v\n", err)
+		return
 	}
+	log.Println(FormatOutcome(outcome))
+}
 
-	var responses []*DAS.Ack
-	var remove []int
-	if VERBOSE {
-		log.Println("--- StartAuction queried ---")
-	}
-	for i, r := range s.clients {
-		ack, err := r.StartAuction(s.ctx, query)
-		if err != nil {
-			remove = append(remove, i)
-			if VERBOSE {
-				log.Printf("Port %v | %s\n", clientToPort[r], err)
+func FormatOutcome(outcome *DAS.Outcome) string {
+	r := fmt.Sprintf("%v", outcome)
+	if len(r) == 0 {
+		r = "There is no such auction"
+	} else {
+		if outcome.Left > 0 && outcome.Format == DAS.Format_DUTCH {
+			r = fmt.Sprintf("| Auction '%s' for '%s' has %vms left, the price is %v", outcome.Auction, outcome.Item, outcome.Left, outcome.ClockPrice)
+		} else if outcome.Left > 0 {
+			if outcome.Bidder != 0 {
+				r = fmt.Sprintf("| Auction '%s' for '%s' has %vms left, highest bid (by id %v) is %v", outcome.Auction, outcome.Item, outcome.Left, outcome.Bidder, outcome.Amount)
+			} else {
+				r = fmt.Sprintf("| Auction '%s' for '%s' has %vms left, starting bid is %v", outcome.Auction, outcome.Item, outcome.Left, outcome.Amount)
+			}
+			if outcome.Minimum > outcome.Amount+1 {
+				r += fmt.Sprintf(", lowest bid accepted is %v", outcome.Minimum)
+			}
+			if outcome.Reserve {
+				r += ", there is a reserve"
+			}
+			if outcome.BuyNow > 0 {
+				r += fmt.Sprintf(", buy it now for %v", outcome.BuyNow)
+			}
+			if outcome.Format == DAS.Format_FIRST_PRICE || outcome.Format == DAS.Format_SECOND_PRICE {
+				r += ", bids are sealed until it is over"
+			}
+		} else if outcome.RevealLeft > 0 {
+			r = fmt.Sprintf("| Auction '%s' for '%s' is over, bids are being revealed for %vms", outcome.Auction, outcome.Item, outcome.RevealLeft)
+		} else {
+			if outcome.Bidder != 0 && outcome.Reserve && !outcome.ReserveMet {
+				r = fmt.Sprintf("| Auction '%s' for '%s' did not sell, highest bid (by id %v) of %v did not meet the reserve", outcome.Auction, outcome.Item, outcome.Bidder, outcome.Amount)
+			} else if outcome.Bidder != 0 && outcome.Price != 0 && outcome.Price != outcome.Amount {
+				r = fmt.Sprintf("| Auction '%s' for '%s' was won (by id %v) with a bid of %v, paying %v", outcome.Auction, outcome.Item, outcome.Bidder, outcome.Amount, outcome.Price)
+			} else if outcome.Bidder != 0 {
+				r = fmt.Sprintf("| Auction '%s' for '%s' was won (by id %v) for %v", outcome.Auction, outcome.Item, outcome.Bidder, outcome.Amount)
+			} else {
+				r = fmt.Sprintf("| Auction '%s' for '%s' did not sell, starting bid was %v", outcome.Auction, outcome.Item, outcome.Amount)
 			}
-			continue
-		}
-		if VERBOSE {
-			log.Printf("Port %v | %s\n", clientToPort[r], ack)
 		}
-		responses = append(responses, ack)
-	}
-	if VERBOSE {
-		log.Println("---------------------")
 	}
+	return r
+}
 
-	for i, val := range remove {
-		// remove from ReplicaServers clients these indexes - since calling them failed
-		s.clients = append(s.clients[:val-i], s.clients[(val-i)+1:]...)
+func FormatEvent(event *DAS.Event) string {
+	outcome := event.Outcome
+	if event.Kind == DAS.Events_BID {
+		return fmt.Sprintf("| Auction '%s' for '%s' got a bid (by id %v) of %v, %vms left", outcome.Auction, outcome.Item, outcome.Bidder, outcome.Amount, outcome.Left)
 	}
-
-	return responses[0]
+	return FormatOutcome(outcome)
 }
 
 // sets the logger to use a log.txt file instead of the console
-func setLog(id uint32) *os.File {
+func setLog(id uint32, precise bool) *os.File {
 	filename := fmt.Sprintf("client-%v.txt", id)
 	// Clears the log.txt file when a new client is started
 	if err := os.Truncate(filename, 0); err != nil {
@@ -346,7 +594,7 @@ func setLog(id uint32) *os.File {
 	// print to both file and console
 	mw := io.MultiWriter(os.Stdout, f)
 
-	if PRECISE_LOGGING {
+	if precise {
 		log.SetFlags(log.LstdFlags | log.Lmicroseconds)
 	}
 
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/LocatedInSpace/Distributed-Auction-System/config"
+)
+
+// Config is every setting of a client, the consts in client.go are the defaults - see the config package for how they are given
+type Config struct {
+	ID             uint32   `yaml:"id" usage:"id to bid with, can also be given as the first argument after the flags"`
+	Peers          []string `yaml:"peers" usage:"comma separated address of every replica in the cluster - empty is replicas ports from base_port on localhost"`
+	BasePort       int      `yaml:"base_port" usage:"port of the first replica, when peers is empty"`
+	Replicas       int      `yaml:"replicas" usage:"amount of replicas in the cluster, when peers is empty"`
+	Verbose        bool     `yaml:"verbose" usage:"print each response from each replica"`
+	PreciseLogging bool     `yaml:"precise_logging" usage:"ups precision on timestamps"`
+	Autoclient     bool     `yaml:"autoclient" usage:"randomly start auctions, bid & get results - instead of reading commands"`
+	MinDelay       int      `yaml:"min_delay" usage:"min milliseconds before the next autoclient roll"`
+	MaxDelay       int      `yaml:"max_delay" usage:"max milliseconds before the next autoclient roll"`
+	Discovery      string   `yaml:"discovery" usage:"how replicas are found, static is peers - file is every replica registered in members_file"`
+	MembersFile    string   `yaml:"members_file" usage:"membership file replicas register in, when discovery is file"`
+}
+
+func LoadConfig() Config {
+	cfg := Config{
+		BasePort:       BASEPORT,
+		Replicas:       REPLICAS,
+		Verbose:        VERBOSE,
+		PreciseLogging: PRECISE_LOGGING,
+		Autoclient:     AUTOCLIENT,
+		MinDelay:       MIN_DELAY,
+		MaxDelay:       MAX_DELAY,
+		Discovery:      DISCOVERY,
+		MembersFile:    MEMBERS_FILE,
+	}
+	args, err := config.Load("client", &cfg, os.Args[1:])
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	// the id used to be the only argument, so it still works without a flag
+	if len(args) > 0 {
+		id, err := strconv.ParseUint(args[0], 10, 32)
+		if err != nil {
+			log.Fatalf("You need to supply a valid uint32 value > 0")
+		}
+		cfg.ID = uint32(id)
+	}
+	if cfg.ID == 0 {
+		log.Fatalf("You need to supply a valid uint32 value > 0")
+	}
+	if cfg.Discovery != "static" && cfg.Discovery != "file" {
+		log.Fatalf("Unknown discovery '%v', it has to be static or file", cfg.Discovery)
+	}
+	if len(cfg.Peers) == 0 {
+		for i := 0; i < cfg.Replicas; i++ {
+			cfg.Peers = append(cfg.Peers, fmt.Sprintf("localhost:%v", cfg.BasePort+i))
+		}
+	}
+	if cfg.MaxDelay <= cfg.MinDelay {
+		cfg.MaxDelay = cfg.MinDelay + 1
+	}
+	return cfg
+}
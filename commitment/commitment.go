@@ -0,0 +1,35 @@
+// Package commitment hides a bid until it is revealed. a bidder sends the Hash of their bid & a random nonce while the
+// auction is live, & the bid & the nonce once it is over - so noone, not even the replicas, knows the bid before then.
+// the auction & the bidder are part of the hash, so a commitment cannot be copied by another bidder or onto another auction
+package commitment
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+)
+
+const NONCE_SIZE = 32 // bytes in a nonce, enough that a bid cannot be guessed by hashing every amount
+
+// Hash returns the commitment to a bid - sha256 of "auction/bidder/amount/" followed by the nonce
+func Hash(auction string, bidder uint32, amount uint64, nonce []byte) []byte {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s/%d/%d/", auction, bidder, amount)
+	h.Write(nonce)
+	return h.Sum(nil)
+}
+
+// Verify returns whether a revealed bid is the one that was committed to
+func Verify(commitment []byte, auction string, bidder uint32, amount uint64, nonce []byte) bool {
+	return bytes.Equal(commitment, Hash(auction, bidder, amount, nonce))
+}
+
+// Nonce returns a new random nonce
+func Nonce() ([]byte, error) {
+	nonce := make([]byte, NONCE_SIZE)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return nonce, nil
+}
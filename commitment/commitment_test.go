@@ -0,0 +1,45 @@
+package commitment
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	nonce := bytes.Repeat([]byte{7}, NONCE_SIZE)
+	commitment := Hash("auction", 1, 50, nonce)
+	tests := []struct {
+		name    string
+		auction string
+		bidder  uint32
+		amount  uint64
+		nonce   []byte
+		ok      bool
+	}{
+		{"the bid committed to", "auction", 1, 50, nonce, true},
+		{"another amount", "auction", 1, 51, nonce, false},
+		{"another bidder", "auction", 2, 50, nonce, false},
+		{"another auction", "other", 1, 50, nonce, false},
+		{"another nonce", "auction", 1, 50, bytes.Repeat([]byte{8}, NONCE_SIZE), false},
+		{"no nonce", "auction", 1, 50, nil, false},
+	}
+	for _, test := range tests {
+		if ok := Verify(commitment, test.auction, test.bidder, test.amount, test.nonce); ok != test.ok {
+			t.Errorf("%v: Verify() = %v, want %v", test.name, ok, test.ok)
+		}
+	}
+}
+
+func TestNonce(t *testing.T) {
+	a, err := Nonce()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := Nonce()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(a) != NONCE_SIZE || bytes.Equal(a, b) {
+		t.Errorf("Nonce() = %x & %x, want two different nonces of %v bytes", a, b, NONCE_SIZE)
+	}
+}
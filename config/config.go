@@ -0,0 +1,151 @@
+// Package config loads the settings of the server & client binaries. every setting can be given in a yaml file,
+// as an environment variable & as a flag - flags win over environment variables, which win over the file.
+//
+// settings are the fields of a struct, named by their yaml tag: the field tagged `yaml:"base_port"` is set by
+// base_port in the file, by DAS_BASE_PORT & by -base-port. a field tagged `usage:"..."` has that as its flag help.
+package config
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const ENV_PREFIX = "DAS_" // prefix of every environment variable
+
+// a flag that only remembers what it was given, so it can be applied after the file & environment
+type raw struct {
+	value  string
+	set    bool
+	isBool bool
+}
+
+func (r *raw) String() string {
+	return r.value
+}
+
+func (r *raw) Set(value string) error {
+	r.value = value
+	r.set = true
+	return nil
+}
+
+func (r *raw) IsBoolFlag() bool {
+	return r.isBool
+}
+
+// Load fills the struct cfg points to - which should already hold the defaults, from the yaml file given by -config
+// (or DAS_CONFIG), the environment & the flags in args. returns the arguments left after the flags
+func Load(name string, cfg interface{}, args []string) ([]string, error) {
+	v := reflect.ValueOf(cfg).Elem()
+	t := v.Type()
+
+	// a bad flag prints the usage & exits, the same as the flag package does for os.Args
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	path := fs.String("config", os.Getenv(ENV_PREFIX+"CONFIG"), "yaml file to load settings from")
+	flags := make([]*raw, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		key := Key(t.Field(i))
+		if key == "" {
+			continue
+		}
+		flags[i] = &raw{value: Format(v.Field(i)), isBool: v.Field(i).Kind() == reflect.Bool}
+		fs.Var(flags[i], strings.ReplaceAll(key, "_", "-"), t.Field(i).Tag.Get("usage"))
+	}
+	fs.Parse(args)
+
+	if *path != "" {
+		data, err := os.ReadFile(*path)
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("%v: %v", *path, err)
+		}
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		key := Key(t.Field(i))
+		if key == "" {
+			continue
+		}
+		env := ENV_PREFIX + strings.ToUpper(key)
+		if value, ok := os.LookupEnv(env); ok {
+			if err := Set(v.Field(i), value); err != nil {
+				return nil, fmt.Errorf("%v: %v", env, err)
+			}
+		}
+		if flags[i].set {
+			if err := Set(v.Field(i), flags[i].value); err != nil {
+				return nil, fmt.Errorf("-%v: %v", strings.ReplaceAll(key, "_", "-"), err)
+			}
+		}
+	}
+	return fs.Args(), nil
+}
+
+// the name of the setting a field holds, empty if it is not a setting
+func Key(field reflect.StructField) string {
+	key := strings.Split(field.Tag.Get("yaml"), ",")[0]
+	if key == "-" {
+		return ""
+	}
+	return key
+}
+
+// sets a field from the string given in a flag or environment variable, lists are comma separated
+func Set(field reflect.Value, value string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported setting type %v", field.Type())
+		}
+		var list []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+		field.Set(reflect.ValueOf(list))
+	default:
+		return fmt.Errorf("unsupported setting type %v", field.Type())
+	}
+	return nil
+}
+
+// the string a field would be set from, used as the flags default
+func Format(field reflect.Value) string {
+	if field.Kind() == reflect.Slice {
+		var list []string
+		for i := 0; i < field.Len(); i++ {
+			list = append(list, fmt.Sprint(field.Index(i).Interface()))
+		}
+		return strings.Join(list, ",")
+	}
+	return fmt.Sprint(field.Interface())
+}
@@ -0,0 +1,727 @@
+// Package dasclient lets Go programs bid on & start auctions in a DAS cluster.
+//
+// A Client sends every request to every replica it is connected to, & only trusts the answer of the leader
+// that a majority of the cluster votes for, see quorum.go. replicas that fail a call are left out, until they
+// answer a ping again - & once a replica joins or leaves the cluster, every reply tells the client about it.
+//
+//	c, err := dasclient.New(42, dasclient.WithReplicas("localhost:7000", "localhost:7001", "localhost:7002"))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer c.Close()
+//	ack, err := c.Bid(ctx, "", 100)
+package dasclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/LocatedInSpace/Distributed-Auction-System/commitment"
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrNoReplicas is returned by New, when no replica accepted the connection - & by Watch, when there are none to watch
+var ErrNoReplicas = errors.New("Could not find any replicas - are you sure they are running?")
+
+// ErrNoSuchAuction is returned by Watch, when no replica knows the auction
+var ErrNoSuchAuction = errors.New("There is no such auction")
+
+// ErrNoCommitment is returned by RevealBid, when the client has not committed to a bid on the auction
+var ErrNoCommitment = errors.New("No bid was committed to on the auction")
+
+// Client is safe to use from several goroutines at once
+type Client struct {
+	id      uint32 // the id bids are placed with
+	options *options
+
+	mutex    sync.Mutex
+	replicas []*replica          // replicas we are connected to, that have not failed a call
+	leader   *replica            // the replica we believe is leader, nil if we do not know
+	latency  map[string]*Latency // how fast each replica has answered, by address
+	clock    uint64              // lamport clock, ticked for every request & moved past the clock of every reply
+	members  []string            // every replica in the cluster, the ones we are not connected to are tried again every refresh
+	epoch    uint64              // membership epoch of members, 0 is the replicas given or resolved
+	latest   uint64              // the highest membership epoch a replica has replied with
+	stop     chan struct{}       // closed by Close, stops refreshing the replicas
+	random   *rand.Rand          // jitters retries, seeded apart from every other client
+	commits  map[string]*sealed  // the bids committed to with CommitBid, by auction id - until they are revealed
+	last     string              // the auction last committed to, revealed by RevealBid with an empty id
+}
+
+// a bid committed to, kept until it is revealed - the nonce is never sent before then
+type sealed struct {
+	amount uint64
+	nonce  []byte
+}
+
+type replica struct {
+	addr string // the address the replica is known by, the same one the replicas use for each other
+	conn *grpc.ClientConn
+	DAS.DASClient
+}
+
+// New connects to the replicas, & returns a client bidding with id. replicas that do not accept
+// the connection within the dial timeout are left out - but count towards the size of the cluster
+func New(id uint32, opts ...Option) (*Client, error) {
+	if id == 0 {
+		return nil, errors.New("id has to be > 0, 0 is no bidder")
+	}
+	o := defaults()
+	for _, opt := range opts {
+		opt(o)
+	}
+	c := &Client{
+		id:      id,
+		options: o,
+		latency: make(map[string]*Latency),
+		members: o.addrs,
+		stop:    make(chan struct{}),
+		commits: make(map[string]*sealed),
+		random:  rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	if o.resolver != nil {
+		addrs, err := o.resolver.Resolve()
+		if err != nil {
+			return nil, err
+		}
+		c.members = addrs
+	}
+	for _, addr := range c.members {
+		if err := c.Connect(addr); err != nil {
+			c.logf("Dial (%v) failed: %s\n", addr, err)
+			continue
+		}
+		c.logf("Dial (%v) succeeded\n", addr)
+	}
+	if len(c.Replicas()) == 0 {
+		return nil, ErrNoReplicas
+	}
+	go c.refresh()
+	return c, nil
+}
+
+// runs until the client is closed - every refresh the members are brought up to date, replicas that are no
+// longer members are removed, & members we are not connected to are included again if they answer a ping
+func (c *Client) refresh() {
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-time.After(c.options.refresh):
+		}
+		c.mutex.Lock()
+		epoch, latest := c.epoch, c.latest
+		c.mutex.Unlock()
+		if latest > epoch {
+			ctx, cancel := context.WithTimeout(context.Background(), c.options.memberTimeout)
+			if _, err := c.Members(ctx); err != nil {
+				c.logf("Could not get the members of epoch %v: %s\n", latest, err)
+			}
+			cancel()
+		} else if c.options.resolver != nil && epoch == 0 {
+			addrs, err := c.options.resolver.Resolve()
+			if err != nil {
+				c.logf("Resolve failed: %s\n", err)
+			} else {
+				c.mutex.Lock()
+				c.members = addrs
+				c.mutex.Unlock()
+			}
+		}
+
+		c.mutex.Lock()
+		members := append([]string{}, c.members...)
+		c.mutex.Unlock()
+		listed := make(map[string]bool)
+		for _, addr := range members {
+			listed[addr] = true
+		}
+		connected := make(map[string]bool)
+		for _, addr := range c.Replicas() {
+			connected[addr] = true
+			if !listed[addr] {
+				c.logf("%v is no longer a member, removing it\n", addr)
+				c.Remove(addr)
+			}
+		}
+		for _, addr := range members {
+			if !connected[addr] {
+				c.include(addr)
+			}
+		}
+	}
+}
+
+// connects to a member we are not connected to, & keeps it if it answers a ping
+func (c *Client) include(addr string) {
+	if err := c.Connect(addr); err != nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.options.pingTimeout)
+	defer cancel()
+	c.mutex.Lock()
+	var r *replica
+	for _, connected := range c.replicas {
+		if connected.addr == addr {
+			r = connected
+		}
+	}
+	c.mutex.Unlock()
+	if r == nil {
+		return
+	}
+	if _, err := r.Ping(ctx, &DAS.Empty{}); err != nil {
+		c.Remove(addr)
+		return
+	}
+	c.logf("%v is healthy, including it again\n", addr)
+}
+
+// Close closes the connection to every replica
+func (c *Client) Close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	select {
+	case <-c.stop:
+	default:
+		close(c.stop)
+	}
+	for _, r := range c.replicas {
+		r.conn.Close()
+	}
+	c.replicas = nil
+	c.leader = nil
+	return nil
+}
+
+// ID is the id the client bids with
+func (c *Client) ID() uint32 {
+	return c.id
+}
+
+// Connect connects to the replica at addr, waiting up to the dial timeout for it to accept
+func (c *Client) Connect(addr string) error {
+	c.mutex.Lock()
+	for _, r := range c.replicas {
+		if r.addr == addr {
+			c.mutex.Unlock()
+			return nil
+		}
+	}
+	c.mutex.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.options.dialTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithInsecure(), grpc.WithBlock(), grpc.WithUnaryInterceptor(askForStatusErrors))
+	if err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.replicas = append(c.replicas, &replica{addr: addr, conn: conn, DASClient: DAS.NewDASClient(conn)})
+	return nil
+}
+
+// Remove disconnects from the replica at addr, it is no longer sent any requests
+func (c *Client) Remove(addr string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for i, r := range c.replicas {
+		if r.addr == addr {
+			r.conn.Close()
+			c.replicas = append(c.replicas[:i], c.replicas[i+1:]...)
+			if c.leader == r {
+				c.leader = nil
+			}
+			break
+		}
+	}
+}
+
+// Replicas returns the addresses of the replicas we are connected to
+func (c *Client) Replicas() []string {
+	var addrs []string
+	for _, r := range c.replicaList() {
+		addrs = append(addrs, r.addr)
+	}
+	return addrs
+}
+
+// Leader returns the address of the replica that answered the last request, empty if we do not know who is leader
+func (c *Client) Leader() string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.leader == nil {
+		return ""
+	}
+	return c.leader.addr
+}
+
+// ClusterSize is how many replicas the cluster has, a majority of them has to answer every request
+func (c *Client) ClusterSize() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.options.cluster != 0 && c.epoch == 0 {
+		return c.options.cluster
+	}
+	return len(c.members)
+}
+
+// Epoch is the membership epoch of the members the client uses, see Members
+func (c *Client) Epoch() uint64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.epoch
+}
+
+// Members asks the leader for every replica in the cluster, & from then on uses them as the cluster -
+// connecting to the ones that joined & disconnecting from the ones that left
+func (c *Client) Members(ctx context.Context) (*DAS.Membership, error) {
+	value, err := c.quorum(ctx, "Members", c.options.memberTimeout, func(ctx context.Context, r *replica) answer {
+		membership, err := r.Members(ctx, &DAS.Empty{})
+		if f := failure(err); f != nil {
+			return answer{leader: r.addr, answered: true, failure: f}
+		} else if err != nil {
+			return answer{err: err}
+		}
+		c.learn(membership.Epoch)
+		if membership.Redirect {
+			return answer{value: membership, leader: membership.Leader}
+		}
+		return answer{value: membership, leader: r.addr, answered: true}
+	})
+	if err != nil {
+		return nil, err
+	}
+	membership := value.(*DAS.Membership)
+	c.mutex.Lock()
+	if membership.Epoch > c.epoch {
+		c.logf("Membership epoch %v, members: %v\n", membership.Epoch, membership.Members)
+		c.members = membership.Members
+		c.epoch = membership.Epoch
+	}
+	c.mutex.Unlock()
+	return membership, nil
+}
+
+// JoinCluster asks the cluster to add the replica at addr, the client connects to it once it knows it has joined
+func (c *Client) JoinCluster(ctx context.Context, addr string) (*DAS.Ack, error) {
+	return c.changeMembers(ctx, "JoinCluster", addr, true)
+}
+
+// LeaveCluster asks the cluster to remove the replica at addr, the client disconnects from it once it knows it has left
+func (c *Client) LeaveCluster(ctx context.Context, addr string) (*DAS.Ack, error) {
+	return c.changeMembers(ctx, "LeaveCluster", addr, false)
+}
+
+func (c *Client) changeMembers(ctx context.Context, name string, addr string, join bool) (*DAS.Ack, error) {
+	member := &DAS.Member{Addr: addr}
+	value, err := c.quorum(ctx, name, c.options.memberTimeout, func(ctx context.Context, r *replica) answer {
+		var ack *DAS.Ack
+		var err error
+		if join {
+			ack, err = r.JoinCluster(ctx, member)
+		} else {
+			ack, err = r.LeaveCluster(ctx, member)
+		}
+		return c.writeAnswer(r, ack, err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*DAS.Ack), nil
+}
+
+// Purge pings every replica, & removes the ones that fail - so requests do not wait on replicas we know are dead.
+// a replica that is too slow to answer is kept
+func (c *Client) Purge(ctx context.Context) {
+	answers := c.askAll(ctx, c.options.pingTimeout, func(ctx context.Context, r *replica) answer {
+		_, err := r.Ping(ctx, &DAS.Empty{})
+		return answer{err: err}
+	}, nil)
+	for _, a := range answers {
+		if code := status.Code(a.err); a.err != nil && code != codes.DeadlineExceeded && code != codes.Canceled {
+			c.Remove(a.replica.addr)
+		}
+	}
+}
+
+// Health asks every replica which of its peers it believes are alive, the replicas that do not answer are left out
+func (c *Client) Health(ctx context.Context) []*DAS.HealthReport {
+	answers := c.askAll(ctx, c.options.pingTimeout, func(ctx context.Context, r *replica) answer {
+		report, err := r.Health(ctx, &DAS.Empty{})
+		return answer{value: report, err: err}
+	}, nil)
+	var reports []*DAS.HealthReport
+	for _, a := range answers {
+		if a.err == nil {
+			reports = append(reports, a.value.(*DAS.HealthReport))
+		}
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].Addr < reports[j].Addr
+	})
+	return reports
+}
+
+// Latency returns how fast each replica has answered, in no particular order
+func (c *Client) Latency() []Latency {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	var latency []Latency
+	for _, l := range c.latency {
+		latency = append(latency, *l)
+	}
+	return latency
+}
+
+// Bid bids amount on the auction with id, an empty id is the last auction started.
+// a bid the leader turns down is an *Error, with the reason - BID_TOO_LOW comes with the highest bid.
+// a bid that fails for a reason that might pass is sent again, with the same request key - so it is only ever applied once.
+// a bid that won the auction outright, at the buy it now price or the price of a dutch auction, is acked with Won set
+func (c *Client) Bid(ctx context.Context, auction string, amount uint64) (*DAS.Ack, error) {
+	query := &DAS.Amount{
+		Id:      c.id,
+		Bid:     amount,
+		Auction: auction,
+		Clock:   c.tick(),
+		Request: uuid.New().String(),
+	}
+	value, err := c.retry(ctx, "SendBid", func() (fmt.Stringer, error) {
+		return c.quorum(ctx, "SendBid", c.options.bidTimeout, func(ctx context.Context, r *replica) answer {
+			ack, err := r.Bid(ctx, query)
+			return c.writeAnswer(r, ack, err)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*DAS.Ack), nil
+}
+
+// SetMaxBid has the leader bid on the clients behalf on the auction with id whenever it is outbid, up to max - an empty id
+// is the last auction started. the bids go up by the increment, & only by as much as it takes to be the highest bid.
+// max is never sent to other clients. a maximum that was outbid right away by a higher one is an *Error with BID_TOO_LOW
+func (c *Client) SetMaxBid(ctx context.Context, auction string, max uint64) (*DAS.Ack, error) {
+	query := &DAS.Amount{
+		Id:      c.id,
+		Bid:     max,
+		Auction: auction,
+		Clock:   c.tick(),
+		Request: uuid.New().String(),
+	}
+	value, err := c.retry(ctx, "SetMaxBid", func() (fmt.Stringer, error) {
+		return c.quorum(ctx, "SetMaxBid", c.options.bidTimeout, func(ctx context.Context, r *replica) answer {
+			ack, err := r.SetMaxBid(ctx, query)
+			return c.writeAnswer(r, ack, err)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*DAS.Ack), nil
+}
+
+// CommitBid commits to a bid of amount on the sealed auction with id, an empty id is the last auction started. only a hash
+// of the bid & a random nonce is sent - the client keeps both, & sends them with RevealBid once the auction is over
+func (c *Client) CommitBid(ctx context.Context, auction string, amount uint64) (*DAS.Ack, error) {
+	nonce, err := commitment.Nonce()
+	if err != nil {
+		return nil, err
+	}
+	// the commitment is made to the id of the auction, so the last auction started has to be looked up
+	if auction == "" {
+		outcome, err := c.Result(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		auction = outcome.Auction
+	}
+	query := &DAS.Amount{
+		Id:         c.id,
+		Auction:    auction,
+		Clock:      c.tick(),
+		Request:    uuid.New().String(),
+		Commitment: commitment.Hash(auction, c.id, amount, nonce),
+	}
+	// the bid & nonce are kept before the commitment is sent - if we time out, it might still have been taken, & the
+	// bid could never be revealed without them. only a commitment the leader turned down puts back what was kept before,
+	// retries have the same request key - so the last answer is the answer to every try
+	c.mutex.Lock()
+	previous, had := c.commits[auction]
+	last := c.last
+	c.commits[auction] = &sealed{amount: amount, nonce: nonce}
+	c.last = auction
+	c.mutex.Unlock()
+	value, err := c.retry(ctx, "CommitBid", func() (fmt.Stringer, error) {
+		return c.quorum(ctx, "CommitBid", c.options.bidTimeout, func(ctx context.Context, r *replica) answer {
+			ack, err := r.CommitBid(ctx, query)
+			return c.writeAnswer(r, ack, err)
+		})
+	})
+	var rejected *Error
+	if (errors.As(err, &rejected) && !Transient(err)) || (err == nil && value.(*DAS.Ack).Response != DAS.Acks_SUCCESS) {
+		c.mutex.Lock()
+		if had {
+			c.commits[auction] = previous
+		} else {
+			delete(c.commits, auction)
+		}
+		c.last = last
+		c.mutex.Unlock()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return value.(*DAS.Ack), nil
+}
+
+// RevealBid reveals the bid committed to on the auction with id, an empty id is the auction last committed to. bids are
+// revealed once the auction is over, until the time to reveal them is up - ErrNoCommitment if there is nothing to reveal
+func (c *Client) RevealBid(ctx context.Context, auction string) (*DAS.Ack, error) {
+	c.mutex.Lock()
+	if auction == "" {
+		auction = c.last
+	}
+	bid, ok := c.commits[auction]
+	c.mutex.Unlock()
+	if !ok {
+		return nil, ErrNoCommitment
+	}
+	query := &DAS.Amount{
+		Id:      c.id,
+		Bid:     bid.amount,
+		Auction: auction,
+		Clock:   c.tick(),
+		Request: uuid.New().String(),
+		Nonce:   bid.nonce,
+	}
+	value, err := c.retry(ctx, "RevealBid", func() (fmt.Stringer, error) {
+		return c.quorum(ctx, "RevealBid", c.options.bidTimeout, func(ctx context.Context, r *replica) answer {
+			ack, err := r.RevealBid(ctx, query)
+			return c.writeAnswer(r, ack, err)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	delete(c.commits, auction)
+	c.mutex.Unlock()
+	return value.(*DAS.Ack), nil
+}
+
+// Result returns the auction with id, an empty id is the last auction started - an *Error with NO_AUCTION if there is no such auction.
+// only the leader answers, since followers might not have applied the latest writes yet
+func (c *Client) Result(ctx context.Context, auction string) (*DAS.Outcome, error) {
+	query := &DAS.Query{
+		Auction: auction,
+	}
+	value, err := c.retry(ctx, "GetResults", func() (fmt.Stringer, error) {
+		return c.quorum(ctx, "GetResults", c.options.resultTimeout, func(ctx context.Context, r *replica) answer {
+			outcome, err := r.Result(ctx, query)
+			return c.readAnswer(r, outcome, err)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*DAS.Outcome), nil
+}
+
+// ListBids returns a page of every bid placed on an auction, in the order the leader applied them - the ones it turned
+// down too, with the reason. pageToken is the NextPageToken of the page before, empty for the first page.
+// a pageSize of 0 is left to the leader
+func (c *Client) ListBids(ctx context.Context, auction string, pageSize uint32, pageToken string) (*DAS.BidPage, error) {
+	query := &DAS.BidsQuery{
+		Auction:   auction,
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	}
+	value, err := c.retry(ctx, "ListBids", func() (fmt.Stringer, error) {
+		return c.quorum(ctx, "ListBids", c.options.resultTimeout, func(ctx context.Context, r *replica) answer {
+			page, err := r.ListBids(ctx, query)
+			if f := failure(err); f != nil {
+				return answer{leader: r.addr, answered: true, failure: f}
+			} else if err != nil {
+				return answer{err: err}
+			}
+			c.learn(page.Epoch)
+			if page.Redirect {
+				return answer{value: page, leader: page.Leader}
+			}
+			return answer{value: page, leader: r.addr, answered: true}
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*DAS.BidPage), nil
+}
+
+// ListAuctions returns a page of the auctions the query asks for, oldest first - set PageToken to the NextPageToken
+// of the page before, to get the next page
+func (c *Client) ListAuctions(ctx context.Context, query *DAS.AuctionsQuery) (*DAS.AuctionPage, error) {
+	value, err := c.retry(ctx, "ListAuctions", func() (fmt.Stringer, error) {
+		return c.quorum(ctx, "ListAuctions", c.options.resultTimeout, func(ctx context.Context, r *replica) answer {
+			page, err := r.ListAuctions(ctx, query)
+			if f := failure(err); f != nil {
+				return answer{leader: r.addr, answered: true, failure: f}
+			} else if err != nil {
+				return answer{err: err}
+			}
+			c.learn(page.Epoch)
+			if page.Redirect {
+				return answer{value: page, leader: page.Leader}
+			}
+			return answer{value: page, leader: r.addr, answered: true}
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*DAS.AuctionPage), nil
+}
+
+// GetAuction returns the auction with id, live or over - an *Error with NO_AUCTION if there is no such auction
+func (c *Client) GetAuction(ctx context.Context, auction string) (*DAS.Outcome, error) {
+	query := &DAS.Query{
+		Auction: auction,
+	}
+	value, err := c.retry(ctx, "GetAuction", func() (fmt.Stringer, error) {
+		return c.quorum(ctx, "GetAuction", c.options.resultTimeout, func(ctx context.Context, r *replica) answer {
+			outcome, err := r.GetAuction(ctx, query)
+			return c.readAnswer(r, outcome, err)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*DAS.Outcome), nil
+}
+
+// StartAuction starts an auction for the item with name, lasting duration milliseconds - the id of the auction is in the ack
+func (c *Client) StartAuction(ctx context.Context, start uint64, duration uint32, name string, opts ...AuctionOption) (*DAS.Ack, error) {
+	query := &DAS.Item{
+		Name:  name,
+		Start: start,
+		Alive: duration,
+		Clock: c.tick(),
+	}
+	for _, opt := range opts {
+		opt(query)
+	}
+	value, err := c.quorum(ctx, "StartAuction", c.options.auctionTimeout, func(ctx context.Context, r *replica) answer {
+		ack, err := r.StartAuction(ctx, query)
+		return c.writeAnswer(r, ack, err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*DAS.Ack), nil
+}
+
+// Watch calls event with every event pushed for the auction with id, until it closes or ctx is done. an auction whose
+// bids are revealed once it is over sends REVEAL when it closes, & CLOSE once the winner is known.
+// any replica can be watched, so if the one we watch dies - we continue on the next.
+// the leader goes first, since a follower might not have applied an auction that was just started
+func (c *Client) Watch(ctx context.Context, auction string, event func(*DAS.Event)) error {
+	c.mutex.Lock()
+	var replicas []*replica
+	if c.leader != nil {
+		replicas = append(replicas, c.leader)
+	}
+	for _, r := range c.replicas {
+		if r != c.leader {
+			replicas = append(replicas, r)
+		}
+	}
+	c.mutex.Unlock()
+	// the client was closed, or every replica it knew was removed - so noone could have said there is no such auction
+	if len(replicas) == 0 {
+		return ErrNoReplicas
+	}
+
+	query := &DAS.Query{
+		Auction: auction,
+	}
+	notFound := 0
+	for _, r := range replicas {
+		stream, err := r.WatchAuction(ctx, query)
+		if err != nil {
+			continue
+		}
+		for {
+			e, err := stream.Recv()
+			if err != nil {
+				c.logf("%v | %s\n", r.addr, err)
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if status.Code(err) == codes.NotFound {
+					notFound++
+				}
+				break
+			}
+			c.witness(e.Outcome.Clock)
+			event(e)
+			if e.Kind == DAS.Events_CLOSE {
+				return nil
+			}
+			// a new replica is not asked for an auction we might not have had the id of
+			query.Auction = e.Outcome.Auction
+		}
+	}
+	if notFound == len(replicas) {
+		return ErrNoSuchAuction
+	}
+	return fmt.Errorf("Stopped watching '%s', no replica could be watched", auction)
+}
+
+// returns the replicas we are connected to, copied so they can be called without holding the mutex
+func (c *Client) replicaList() []*replica {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return append([]*replica{}, c.replicas...)
+}
+
+// returns the clock to send a request with
+func (c *Client) tick() uint64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.clock++
+	return c.clock
+}
+
+// notes the membership epoch of a reply, the members are asked for on the next refresh if it is newer than ours
+func (c *Client) learn(epoch uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if epoch > c.latest {
+		c.latest = epoch
+	}
+}
+
+// moves our clock past the clock of a reply
+func (c *Client) witness(clock uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if clock > c.clock {
+		c.clock = clock
+	}
+}
+
+func (c *Client) logf(format string, v ...interface{}) {
+	if c.options.logger != nil {
+		c.options.logger.Printf(format, v...)
+	}
+}
@@ -0,0 +1,93 @@
+package dasclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// the client asks the replicas to answer with status errors, instead of acks saying FAIL or EXCEPTION - see errors.go in the server
+const statusErrorsHeader = "das-errors"
+
+// Error is a request the leader turned down, Reason says why - & the fields that apply to the reason are set
+type Error struct {
+	Code    codes.Code
+	Reason  DAS.Reason
+	Message string
+	Auction string // id of the auction the request was for, if there is one
+	Highest uint64 // the highest bid, with BID_TOO_LOW
+	Bidder  uint32 // id of the highest bidder, with BID_TOO_LOW
+	Minimum uint64 // the lowest bid that would have been accepted, with BID_TOO_LOW
+}
+
+func (e *Error) Error() string {
+	if e.Reason == DAS.Reason_BID_TOO_LOW && e.Bidder == 0 && e.Highest == 0 {
+		// a dutch auction only has a price
+		return fmt.Sprintf("%s (%v), the lowest bid accepted is %v", e.Message, e.Reason, e.Minimum)
+	} else if e.Reason == DAS.Reason_BID_TOO_LOW {
+		highest := fmt.Sprintf("highest bid (by id %v) is %v", e.Bidder, e.Highest)
+		if e.Bidder == 0 {
+			highest = fmt.Sprintf("starting bid is %v", e.Highest)
+		}
+		if e.Minimum > e.Highest+1 {
+			return fmt.Sprintf("%s (%v), %s - the lowest bid accepted is %v", e.Message, e.Reason, highest, e.Minimum)
+		}
+		return fmt.Sprintf("%s (%v), %s", e.Message, e.Reason, highest)
+	}
+	return fmt.Sprintf("%s (%v)", e.Message, e.Reason)
+}
+
+// IsReason returns whether err is the leader turning the request down for reason
+func IsReason(err error, reason DAS.Reason) bool {
+	var e *Error
+	return errors.As(err, &e) && e.Reason == reason
+}
+
+// Transient returns whether err might pass, if the request is sent again - the cluster had no leader or majority,
+// or the leader could not commit the request in time
+func Transient(err error) bool {
+	var noQuorum *NoQuorumError
+	var disagreement *DisagreementError
+	var e *Error
+	switch {
+	case errors.As(err, &noQuorum), errors.As(err, &disagreement), errors.Is(err, ErrNoLeader):
+		return true
+	case errors.As(err, &e):
+		return e.Code == codes.Unavailable || e.Code == codes.Aborted
+	}
+	return false
+}
+
+// returns the failure a status error carries, nil if it is not one - so the replica did not turn the request down, the call failed
+func failure(err error) *Error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+	for _, detail := range st.Details() {
+		if f, ok := detail.(*DAS.Failure); ok {
+			return &Error{
+				Code:    st.Code(),
+				Reason:  f.Reason,
+				Message: st.Message(),
+				Auction: f.Auction,
+				Highest: f.Highest,
+				Bidder:  f.Bidder,
+				Minimum: f.Minimum,
+			}
+		}
+	}
+	return nil
+}
+
+// adds statusErrorsHeader to every call
+func askForStatusErrors(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	ctx = metadata.AppendToOutgoingContext(ctx, statusErrorsHeader, "status")
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
@@ -0,0 +1,236 @@
+package dasclient
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/LocatedInSpace/Distributed-Auction-System/discovery"
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+)
+
+// the defaults match a cluster started with `go run .\server` on one machine
+const BASEPORT = 7000 // port the first replica listens on, the others use the ports after it
+const REPLICAS = 4    // amount of replicas in the cluster
+
+const DIAL_TIMEOUT = 1000 // milliseconds to wait for each replica to accept the connection
+
+// milliseconds each replica gets to answer a call, before it is given up on. the leader waits up to 2000ms
+// for a write to reach a majority (WRITE_TIMEOUT in server.go) - so bids & auctions have to be given longer than that
+const BID_TIMEOUT = 3000
+const AUCTION_TIMEOUT = 3000
+const RESULT_TIMEOUT = 3000
+const PING_TIMEOUT = 500
+const MEMBERSHIP_TIMEOUT = 3000
+
+const ATTEMPTS = 10        // how many times a request is sent to every replica, while they have no leader, before giving up
+const REDIRECT_DELAY = 250 // milliseconds to wait for the replicas to elect a leader
+const RETRIES = 3          // how many times a bid or read that failed for a reason that might pass, is sent again
+const RETRY_BACKOFF = 100  // milliseconds to wait before the first retry, doubled for each retry after it
+const REFRESH_EVERY = 1000 // milliseconds between each time replicas that are down are tried again, & the replicas are resolved again
+
+// Option configures a Client, see New
+type Option func(*options)
+
+type options struct {
+	addrs          []string
+	resolver       discovery.Resolver // replaces addrs, if set
+	refresh        time.Duration
+	cluster        int // amount of replicas in the cluster, 0 is the amount of addresses
+	dialTimeout    time.Duration
+	bidTimeout     time.Duration
+	auctionTimeout time.Duration
+	resultTimeout  time.Duration
+	pingTimeout    time.Duration
+	memberTimeout  time.Duration
+	attempts       int
+	redirectDelay  time.Duration
+	retries        int
+	retryBackoff   time.Duration
+	logger         *log.Logger // every answer from every replica is logged to it, nil logs nothing
+}
+
+func defaults() *options {
+	o := &options{
+		dialTimeout:    DIAL_TIMEOUT * time.Millisecond,
+		bidTimeout:     BID_TIMEOUT * time.Millisecond,
+		auctionTimeout: AUCTION_TIMEOUT * time.Millisecond,
+		resultTimeout:  RESULT_TIMEOUT * time.Millisecond,
+		pingTimeout:    PING_TIMEOUT * time.Millisecond,
+		memberTimeout:  MEMBERSHIP_TIMEOUT * time.Millisecond,
+		attempts:       ATTEMPTS,
+		redirectDelay:  REDIRECT_DELAY * time.Millisecond,
+		retries:        RETRIES,
+		retryBackoff:   RETRY_BACKOFF * time.Millisecond,
+		refresh:        REFRESH_EVERY * time.Millisecond,
+	}
+	WithLocalCluster(BASEPORT, REPLICAS)(o)
+	return o
+}
+
+// WithReplicas sets the addresses of the replicas, the cluster is assumed to be exactly these replicas
+func WithReplicas(addrs ...string) Option {
+	return func(o *options) {
+		o.addrs = append([]string{}, addrs...)
+	}
+}
+
+// WithResolver finds the replicas with resolver, instead of a fixed list - they are resolved again every refresh,
+// so the client connects to replicas that join & disconnects from ones that leave. the cluster is assumed to be
+// the replicas last resolved, unless WithClusterSize is given - or until a replica has joined or left through the cluster
+func WithResolver(resolver discovery.Resolver) Option {
+	return func(o *options) {
+		o.resolver = resolver
+	}
+}
+
+// WithRefresh sets how often replicas that are down are tried again, & how often the resolver is asked for the replicas
+func WithRefresh(refresh time.Duration) Option {
+	return func(o *options) {
+		o.refresh = refresh
+	}
+}
+
+// WithLocalCluster sets the replicas to localhost, on replicas ports from basePort
+func WithLocalCluster(basePort int, replicas int) Option {
+	return func(o *options) {
+		o.addrs = nil
+		for i := 0; i < replicas; i++ {
+			o.addrs = append(o.addrs, fmt.Sprintf("localhost:%v", basePort+i))
+		}
+	}
+}
+
+// WithClusterSize sets how many replicas the cluster has, if not every replica is given to the client.
+// a majority of this many replicas has to answer every request
+func WithClusterSize(replicas int) Option {
+	return func(o *options) {
+		o.cluster = replicas
+	}
+}
+
+// WithDialTimeout sets how long each replica gets to accept the connection, replicas that do not are left out
+func WithDialTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.dialTimeout = timeout
+	}
+}
+
+// WithBidTimeout sets how long each replica gets to answer a bid
+func WithBidTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.bidTimeout = timeout
+	}
+}
+
+// WithAuctionTimeout sets how long each replica gets to answer a request to start an auction
+func WithAuctionTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.auctionTimeout = timeout
+	}
+}
+
+// WithResultTimeout sets how long each replica gets to answer a request for the result of an auction
+func WithResultTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.resultTimeout = timeout
+	}
+}
+
+// WithPingTimeout sets how long each replica gets to answer a ping, see Purge
+func WithPingTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.pingTimeout = timeout
+	}
+}
+
+// WithMembershipTimeout sets how long each replica gets to answer a request to join or leave the cluster, or for its members
+func WithMembershipTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.memberTimeout = timeout
+	}
+}
+
+// WithAttempts sets how many times a request is sent to every replica while they have no leader,
+// & how long to wait between each time for them to elect one
+func WithAttempts(attempts int, delay time.Duration) Option {
+	return func(o *options) {
+		o.attempts = attempts
+		o.redirectDelay = delay
+	}
+}
+
+// WithRetries sets how many times a bid or read that failed for a reason that might pass (see Transient) is sent again,
+// & how long to wait before the first retry - it is doubled for each retry after it. bids are retried with the same
+// request key, so the leader never applies one twice
+func WithRetries(retries int, backoff time.Duration) Option {
+	return func(o *options) {
+		o.retries = retries
+		o.retryBackoff = backoff
+	}
+}
+
+// WithLogger logs every answer from every replica, & how long it took
+func WithLogger(logger *log.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// AuctionOption sets how an auction started with StartAuction runs
+type AuctionOption func(*DAS.Item)
+
+// WithSoftClose has a bid accepted within the last within of the auction extend it by extend, so noone can win by bidding
+// at the last moment - the auction keeps going for as long as bids keep coming in
+func WithSoftClose(within time.Duration, extend time.Duration) AuctionOption {
+	return func(item *DAS.Item) {
+		item.ExtendWithin = uint32(within.Milliseconds())
+		item.ExtendBy = uint32(extend.Milliseconds())
+	}
+}
+
+// WithIncrement has a bid be at least increment higher than the highest bid, or percent of it - whichever is more
+func WithIncrement(increment uint64, percent uint32) AuctionOption {
+	return func(item *DAS.Item) {
+		item.Increment = increment
+		item.IncrementPercent = percent
+	}
+}
+
+// WithReserve keeps the item from selling for less than reserve. bidders are told there is a reserve, but not what it is -
+// only whether it was met, once the auction is over
+func WithReserve(reserve uint64) AuctionOption {
+	return func(item *DAS.Item) {
+		item.Reserve = reserve
+	}
+}
+
+// WithFormat sets how the auction is run, an open auction unless it is set - see DAS.Format
+func WithFormat(format DAS.Format) AuctionOption {
+	return func(item *DAS.Item) {
+		item.Format = format
+	}
+}
+
+// WithDrop has the price of a dutch auction drop by drop every every, from the starting bid down to the reserve
+func WithDrop(drop uint64, every time.Duration) AuctionOption {
+	return func(item *DAS.Item) {
+		item.Drop = drop
+		item.DropEvery = uint32(every.Milliseconds())
+	}
+}
+
+// WithReveal has a sealed auction take commitments instead of bids, revealed for reveal once it is over - so not even
+// the replicas know the bids while it is live, see Client.CommitBid
+func WithReveal(reveal time.Duration) AuctionOption {
+	return func(item *DAS.Item) {
+		item.RevealFor = uint32(reveal.Milliseconds())
+	}
+}
+
+// WithBuyNow has the first bid of at least price win an open auction outright, & pay price - the auction is over right away
+func WithBuyNow(price uint64) AuctionOption {
+	return func(item *DAS.Item) {
+		item.BuyNow = price
+	}
+}
@@ -0,0 +1,274 @@
+package dasclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// every request is sent to every replica in parallel, & each replica votes for who it believes is leader - followers by
+// redirecting, the leader by answering. the leaders answer is only returned if a majority of the cluster votes for it
+
+// ErrNoLeader is returned when a majority agreed on there being no leader, for every attempt
+var ErrNoLeader = errors.New("Could not reach the leader")
+
+// NoQuorumError is returned when less than a majority of the cluster answered, so there is no telling what the cluster agrees on
+type NoQuorumError struct {
+	Answered int
+	Needed   int
+	Cluster  int
+}
+
+func (e *NoQuorumError) Error() string {
+	return fmt.Sprintf("No quorum, %v replicas answered - %v of %v are needed", e.Answered, e.Needed, e.Cluster)
+}
+
+// DisagreementError is returned when a majority answered, but no majority agrees on who is leader - so no answer can be trusted
+type DisagreementError struct {
+	Votes map[string]int // how many replicas believe each address is leader, empty is no leader
+}
+
+func (e *DisagreementError) Error() string {
+	var views []string
+	for leader, votes := range e.Votes {
+		if leader == "" {
+			leader = "no leader"
+		}
+		views = append(views, fmt.Sprintf("%v (%v)", leader, votes))
+	}
+	sort.Strings(views)
+	return fmt.Sprintf("Replicas disagree on who is leader: %s", strings.Join(views, ", "))
+}
+
+// the answer of one replica, to a request sent to every replica
+type answer struct {
+	replica  *replica
+	latency  time.Duration
+	value    fmt.Stringer // the ack or outcome the replica answered with
+	leader   string       // the replica it believes is leader, itself if it answered - empty if it does not know
+	answered bool         // false if the replica redirected instead of answering
+	failure  *Error       // set if the replica answered, by turning the request down
+	err      error        // set if the call failed, so the replica did not answer
+}
+
+// Latency is how fast a replica has answered the calls of a client - calls cancelled once the quorum was decided,
+// count with how long they had been running. so a hung replica still shows up as slow
+type Latency struct {
+	Addr      string
+	Calls     int
+	Timeouts  int
+	Cancelled int
+	Total     time.Duration
+	Max       time.Duration
+	Last      time.Duration
+}
+
+// Average is the average time the replica took to answer
+func (l Latency) Average() time.Duration {
+	if l.Calls == 0 {
+		return 0
+	}
+	return l.Total / time.Duration(l.Calls)
+}
+
+// sends a request to every replica in parallel, & returns the answer of the leader a majority votes for.
+// while a majority agrees that there is no leader - they are given time to elect one
+func (c *Client) quorum(ctx context.Context, name string, timeout time.Duration, call func(context.Context, *replica) answer) (fmt.Stringer, error) {
+	c.logf("--- %s queried ---\n", name)
+	defer c.logf("---------------------\n")
+	cluster := c.ClusterSize()
+	needed := cluster/2 + 1
+	for attempt := 0; attempt < c.options.attempts; attempt++ {
+		// the replicas still answering are cancelled, once a majority has voted for a leader that answered
+		answers := c.askAll(ctx, timeout, call, func(answers []answer) bool {
+			return elected(answers, needed) != nil
+		})
+		votes := make(map[string]int)
+		answered := 0
+		for _, a := range answers {
+			if a.err != nil {
+				c.logf("%v | %vms | %s\n", a.replica.addr, a.latency.Milliseconds(), a.err)
+				// a replica that is slow, is not necessarily dead - & one we gave up on is not either
+				if code := status.Code(a.err); code != codes.DeadlineExceeded && code != codes.Canceled {
+					c.Remove(a.replica.addr)
+				}
+				continue
+			}
+			if a.failure != nil {
+				c.logf("%v | %vms | %s\n", a.replica.addr, a.latency.Milliseconds(), a.failure)
+			} else {
+				c.logf("%v | %vms | %s\n", a.replica.addr, a.latency.Milliseconds(), a.value)
+			}
+			answered++
+			votes[a.leader]++
+		}
+		if leader := elected(answers, needed); leader != nil {
+			c.mutex.Lock()
+			c.leader = leader.replica
+			c.mutex.Unlock()
+			if leader.failure != nil {
+				return nil, leader.failure
+			}
+			return leader.value, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if answered < needed {
+			return nil, &NoQuorumError{Answered: answered, Needed: needed, Cluster: cluster}
+		}
+		agreed := false
+		for _, count := range votes {
+			if count >= needed {
+				agreed = true
+			}
+		}
+		if !agreed {
+			return nil, &DisagreementError{Votes: votes}
+		}
+		// the majority has no leader, or the leader they agree on could not answer yet
+		select {
+		case <-time.After(c.options.redirectDelay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return nil, ErrNoLeader
+}
+
+// sends a request until it succeeds, fails for a reason that will not pass or ctx is done - waiting longer between each time
+func (c *Client) retry(ctx context.Context, name string, request func() (fmt.Stringer, error)) (fmt.Stringer, error) {
+	backoff := c.options.retryBackoff
+	for retry := 0; ; retry++ {
+		value, err := request()
+		if err == nil || retry >= c.options.retries || !Transient(err) {
+			return value, err
+		}
+		// jittered, so clients that failed together do not all retry together
+		c.mutex.Lock()
+		wait := backoff/2 + time.Duration(c.random.Int63n(int64(backoff)+1))
+		c.mutex.Unlock()
+		c.logf("%s failed (%s), retrying in %vms\n", name, err, wait.Milliseconds())
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		backoff *= 2
+	}
+}
+
+// returns the answer of the leader, if a majority has voted for it - nil if there is no such leader yet
+func elected(answers []answer, needed int) *answer {
+	votes := make(map[string]int)
+	for _, a := range answers {
+		if a.err == nil && a.leader != "" {
+			votes[a.leader]++
+		}
+	}
+	for i, a := range answers {
+		if a.err == nil && a.answered && votes[a.leader] >= needed && a.replica.addr == a.leader {
+			return &answers[i]
+		}
+	}
+	return nil
+}
+
+// calls every replica in parallel, each with its own deadline - & returns their answers once all of them have answered.
+// if done says the answers so far are enough, the calls still running are cancelled & left out
+func (c *Client) askAll(ctx context.Context, timeout time.Duration, call func(context.Context, *replica) answer, done func([]answer) bool) []answer {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	replicas := c.replicaList()
+	// buffered, so the calls we stop waiting for can still finish
+	results := make(chan answer, len(replicas))
+	for _, r := range replicas {
+		go func(r *replica) {
+			callCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			sent := time.Now()
+			a := call(callCtx, r)
+			a.replica = r
+			a.latency = time.Since(sent)
+			results <- a
+		}(r)
+	}
+	sent := time.Now()
+	pending := make(map[*replica]bool)
+	for _, r := range replicas {
+		pending[r] = true
+	}
+	var answers []answer
+	for range replicas {
+		a := <-results
+		c.measure(a)
+		delete(pending, a.replica)
+		answers = append(answers, a)
+		if done != nil && done(answers) {
+			break
+		}
+	}
+	for r := range pending {
+		c.measure(answer{replica: r, latency: time.Since(sent), err: context.Canceled})
+	}
+	return answers
+}
+
+// adds the latency of an answer to the replicas stats
+func (c *Client) measure(a answer) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	latency, ok := c.latency[a.replica.addr]
+	if !ok {
+		latency = &Latency{Addr: a.replica.addr}
+		c.latency[a.replica.addr] = latency
+	}
+	latency.Calls++
+	if status.Code(a.err) == codes.DeadlineExceeded {
+		latency.Timeouts++
+	} else if a.err == context.Canceled {
+		latency.Cancelled++
+	}
+	latency.Total += a.latency
+	latency.Last = a.latency
+	if a.latency > latency.Max {
+		latency.Max = a.latency
+	}
+}
+
+// turns the reply to a write into a vote, a follower votes for the leader it redirects to - & only the leader turns requests down
+func (c *Client) writeAnswer(r *replica, ack *DAS.Ack, err error) answer {
+	if f := failure(err); f != nil {
+		return answer{leader: r.addr, answered: true, failure: f}
+	} else if err != nil {
+		return answer{err: err}
+	}
+	c.witness(ack.Clock)
+	c.learn(ack.Epoch)
+	if ack.Response == DAS.Acks_REDIRECT {
+		return answer{value: ack, leader: ack.Leader}
+	}
+	return answer{value: ack, leader: r.addr, answered: true}
+}
+
+// turns the reply to a read into a vote, same as writeAnswer
+func (c *Client) readAnswer(r *replica, outcome *DAS.Outcome, err error) answer {
+	if f := failure(err); f != nil {
+		return answer{leader: r.addr, answered: true, failure: f}
+	} else if err != nil {
+		return answer{err: err}
+	}
+	c.witness(outcome.Clock)
+	c.learn(outcome.Epoch)
+	if outcome.Redirect {
+		return answer{value: outcome, leader: outcome.Leader}
+	}
+	return answer{value: outcome, leader: r.addr, answered: true}
+}
@@ -0,0 +1,104 @@
+// Package discovery finds the replicas of a cluster. a Static list is given up front, while a File is a membership
+// file shared by everyone on the machine (or a shared drive) - replicas register their address in it & renew it every
+// REGISTER_EVERY, & a replica that has not renewed its address within LEASE is left out as dead.
+package discovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+)
+
+const REGISTER_EVERY = 1000 // milliseconds between each time a replica renews its registration
+const LEASE = 3000          // milliseconds a registration lasts without being renewed
+
+// Resolver returns the addresses of the live replicas, every call - so replicas that join or leave are picked up
+type Resolver interface {
+	Resolve() ([]string, error)
+}
+
+// Static is a fixed list of replicas, given in the config
+type Static []string
+
+func (s Static) Resolve() ([]string, error) {
+	return append([]string{}, s...), nil
+}
+
+// File is a membership file, holding the address of every registered replica & when it last renewed it.
+// writers replace the whole file, so two replicas registering at the same time might lose one of the registrations -
+// it is back once that replica renews it
+type File struct {
+	Path string
+}
+
+func (f File) Resolve() ([]string, error) {
+	members, err := f.read()
+	if err != nil {
+		return nil, err
+	}
+	var addrs []string
+	now := time.Now().UnixMilli()
+	for addr, seen := range members {
+		if now-seen < LEASE {
+			addrs = append(addrs, addr)
+		}
+	}
+	sort.Strings(addrs)
+	return addrs, nil
+}
+
+// Register adds or renews the registration of a replica, registrations that have run out are dropped while at it
+func (f File) Register(addr string) error {
+	members, err := f.read()
+	if err != nil {
+		return err
+	}
+	now := time.Now().UnixMilli()
+	for member, seen := range members {
+		if now-seen >= LEASE {
+			delete(members, member)
+		}
+	}
+	members[addr] = now
+	return f.write(members)
+}
+
+// Deregister removes a replica, so it is left out right away - instead of once its lease runs out
+func (f File) Deregister(addr string) error {
+	members, err := f.read()
+	if err != nil {
+		return err
+	}
+	delete(members, addr)
+	return f.write(members)
+}
+
+// the file is a json object, of each address & the unix milliseconds it was last renewed at. no file is no members
+func (f File) read() (map[string]int64, error) {
+	members := make(map[string]int64)
+	data, err := os.ReadFile(f.Path)
+	if os.IsNotExist(err) || len(data) == 0 {
+		return members, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, fmt.Errorf("%v: %v", f.Path, err)
+	}
+	return members, nil
+}
+
+// written to a file of its own first & then renamed, so noone ever reads half a file
+func (f File) write(members map[string]int64) error {
+	data, err := json.MarshalIndent(members, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := fmt.Sprintf("%v.%v.tmp", f.Path, os.Getpid())
+	if err := os.WriteFile(tmp, data, 0666); err != nil {
+		return err
+	}
+	return os.Rename(tmp, f.Path)
+}
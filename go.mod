@@ -9,6 +9,7 @@ require (
 	golang.design/x/clipboard v0.6.3
 	google.golang.org/grpc v1.50.1
 	google.golang.org/protobuf v1.28.1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -26,6 +26,7 @@ const (
 	Acks_FAIL      Acks = 0
 	Acks_SUCCESS   Acks = 1
 	Acks_EXCEPTION Acks = 2
+	Acks_REDIRECT  Acks = 3 // the replica is a follower, the request has to be sent to the leader
 )
 
 // Enum value maps for Acks.
@@ -34,11 +35,13 @@ var (
 		0: "FAIL",
 		1: "SUCCESS",
 		2: "EXCEPTION",
+		3: "REDIRECT",
 	}
 	Acks_value = map[string]int32{
 		"FAIL":      0,
 		"SUCCESS":   1,
 		"EXCEPTION": 2,
+		"REDIRECT":  3,
 	}
 )
 
@@ -69,17 +72,274 @@ func (Acks) EnumDescriptor() ([]byte, []int) {
 	return file_proto_das_proto_rawDescGZIP(), []int{0}
 }
 
-type Amount struct {
+// why a request was turned down - clients that ask for status errors get it in a Failure, with the status code below.
+// clients that read acks get it in the ack, with FAIL or EXCEPTION & the message
+type Reason int32
+
+const (
+	Reason_NO_REASON           Reason = 0
+	Reason_AUCTION_CLOSED      Reason = 1  // FAILED_PRECONDITION, the auction is over
+	Reason_BID_TOO_LOW         Reason = 2  // FAILED_PRECONDITION, the bid is not higher than the highest bid - which is in the failure
+	Reason_NO_AUCTION          Reason = 3  // NOT_FOUND, there is no such auction
+	Reason_NOT_UNIQUE          Reason = 4  // ALREADY_EXISTS, the auction id is already in use
+	Reason_NO_MAJORITY         Reason = 5  // UNAVAILABLE, the leader believes too few replicas are alive to commit the request
+	Reason_TIMED_OUT           Reason = 6  // UNAVAILABLE, the request was not committed in time - it might still be
+	Reason_LEADER_CHANGED      Reason = 7  // UNAVAILABLE, the leader changed before the request was committed, it was lost
+	Reason_MEMBERSHIP_CHANGING Reason = 8  // ABORTED, another membership change has not been committed yet
+	Reason_LAST_MEMBER         Reason = 9  // FAILED_PRECONDITION, the last replica cannot leave the cluster
+	Reason_BAD_PAGE_TOKEN      Reason = 10 // INVALID_ARGUMENT, the page token is not from the same listing
+	Reason_ALREADY_BID         Reason = 11 // FAILED_PRECONDITION, a sealed auction only takes one bid from each bidder
+	Reason_NOT_SUPPORTED       Reason = 12 // FAILED_PRECONDITION, the auction is not of a format that takes the request
+	Reason_NOT_REVEALING       Reason = 13 // FAILED_PRECONDITION, bids are only revealed once the auction is over, until reveal_for is up
+	Reason_BAD_COMMITMENT      Reason = 14 // INVALID_ARGUMENT, the commitment is not a hash - or the bid revealed is not the one committed to
+	Reason_BAD_AUCTION         Reason = 15 // INVALID_ARGUMENT, the auction was started with settings that do not go together
+)
+
+// Enum value maps for Reason.
+var (
+	Reason_name = map[int32]string{
+		0:  "NO_REASON",
+		1:  "AUCTION_CLOSED",
+		2:  "BID_TOO_LOW",
+		3:  "NO_AUCTION",
+		4:  "NOT_UNIQUE",
+		5:  "NO_MAJORITY",
+		6:  "TIMED_OUT",
+		7:  "LEADER_CHANGED",
+		8:  "MEMBERSHIP_CHANGING",
+		9:  "LAST_MEMBER",
+		10: "BAD_PAGE_TOKEN",
+		11: "ALREADY_BID",
+		12: "NOT_SUPPORTED",
+		13: "NOT_REVEALING",
+		14: "BAD_COMMITMENT",
+		15: "BAD_AUCTION",
+	}
+	Reason_value = map[string]int32{
+		"NO_REASON":           0,
+		"AUCTION_CLOSED":      1,
+		"BID_TOO_LOW":         2,
+		"NO_AUCTION":          3,
+		"NOT_UNIQUE":          4,
+		"NO_MAJORITY":         5,
+		"TIMED_OUT":           6,
+		"LEADER_CHANGED":      7,
+		"MEMBERSHIP_CHANGING": 8,
+		"LAST_MEMBER":         9,
+		"BAD_PAGE_TOKEN":      10,
+		"ALREADY_BID":         11,
+		"NOT_SUPPORTED":       12,
+		"NOT_REVEALING":       13,
+		"BAD_COMMITMENT":      14,
+		"BAD_AUCTION":         15,
+	}
+)
+
+func (x Reason) Enum() *Reason {
+	p := new(Reason)
+	*p = x
+	return p
+}
+
+func (x Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_das_proto_enumTypes[1].Descriptor()
+}
+
+func (Reason) Type() protoreflect.EnumType {
+	return &file_proto_das_proto_enumTypes[1]
+}
+
+func (x Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Reason.Descriptor instead.
+func (Reason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{1}
+}
+
+type Events int32
+
+const (
+	Events_TICK   Events = 0 // sent every second, with the time left
+	Events_BID    Events = 1 // a bid was accepted
+	Events_CLOSE  Events = 2 // the auction is over & the winner is known, it is the last event sent
+	Events_REVEAL Events = 3 // the auction is over, & bids are being revealed for reveal_left milliseconds - CLOSE is sent once they are
+)
+
+// Enum value maps for Events.
+var (
+	Events_name = map[int32]string{
+		0: "TICK",
+		1: "BID",
+		2: "CLOSE",
+		3: "REVEAL",
+	}
+	Events_value = map[string]int32{
+		"TICK":   0,
+		"BID":    1,
+		"CLOSE":  2,
+		"REVEAL": 3,
+	}
+)
+
+func (x Events) Enum() *Events {
+	p := new(Events)
+	*p = x
+	return p
+}
+
+func (x Events) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Events) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_das_proto_enumTypes[2].Descriptor()
+}
+
+func (Events) Type() protoreflect.EnumType {
+	return &file_proto_das_proto_enumTypes[2]
+}
+
+func (x Events) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Events.Descriptor instead.
+func (Events) EnumDescriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{2}
+}
+
+// how an auction is run
+type Format int32
+
+const (
+	Format_OPEN         Format = 0 // every bid has to beat the highest bid, which everyone can see
+	Format_FIRST_PRICE  Format = 1 // sealed, each bidder bids once without seeing the other bids - the highest bid wins & pays what it bid
+	Format_SECOND_PRICE Format = 2 // sealed the same way, but the winner pays the second highest bid (vickrey)
+	Format_DUTCH        Format = 3 // the price starts at the starting bid & drops on a schedule, the first bid at the price wins right away
+)
+
+// Enum value maps for Format.
+var (
+	Format_name = map[int32]string{
+		0: "OPEN",
+		1: "FIRST_PRICE",
+		2: "SECOND_PRICE",
+		3: "DUTCH",
+	}
+	Format_value = map[string]int32{
+		"OPEN":         0,
+		"FIRST_PRICE":  1,
+		"SECOND_PRICE": 2,
+		"DUTCH":        3,
+	}
+)
+
+func (x Format) Enum() *Format {
+	p := new(Format)
+	*p = x
+	return p
+}
+
+func (x Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_das_proto_enumTypes[3].Descriptor()
+}
+
+func (Format) Type() protoreflect.EnumType {
+	return &file_proto_das_proto_enumTypes[3]
+}
+
+func (x Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Format.Descriptor instead.
+func (Format) EnumDescriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{3}
+}
+
+// which auctions ListAuctions returns
+type Filter int32
+
+const (
+	Filter_ALL    Filter = 0
+	Filter_LIVE   Filter = 1 // not over yet
+	Filter_CLOSED Filter = 2 // over, sold or not
+	Filter_UNSOLD Filter = 3 // over without a bid, or without one meeting the reserve
+	Filter_SOLD   Filter = 4 // over with a winner
+)
+
+// Enum value maps for Filter.
+var (
+	Filter_name = map[int32]string{
+		0: "ALL",
+		1: "LIVE",
+		2: "CLOSED",
+		3: "UNSOLD",
+		4: "SOLD",
+	}
+	Filter_value = map[string]int32{
+		"ALL":    0,
+		"LIVE":   1,
+		"CLOSED": 2,
+		"UNSOLD": 3,
+		"SOLD":   4,
+	}
+)
+
+func (x Filter) Enum() *Filter {
+	p := new(Filter)
+	*p = x
+	return p
+}
+
+func (x Filter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Filter) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_das_proto_enumTypes[4].Descriptor()
+}
+
+func (Filter) Type() protoreflect.EnumType {
+	return &file_proto_das_proto_enumTypes[4]
+}
+
+func (x Filter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Filter.Descriptor instead.
+func (Filter) EnumDescriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{4}
+}
+
+// the details of a status error, see Reason
+type Failure struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id  uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Bid uint64 `protobuf:"varint,2,opt,name=bid,proto3" json:"bid,omitempty"`
+	Reason  Reason `protobuf:"varint,1,opt,name=reason,proto3,enum=proto.Reason" json:"reason,omitempty"`
+	Auction string `protobuf:"bytes,2,opt,name=auction,proto3" json:"auction,omitempty"`  // id of the auction the request was for, if there is one
+	Highest uint64 `protobuf:"varint,3,opt,name=highest,proto3" json:"highest,omitempty"` // the highest bid, set with BID_TOO_LOW
+	Bidder  uint32 `protobuf:"varint,4,opt,name=bidder,proto3" json:"bidder,omitempty"`   // id of the highest bidder, set with BID_TOO_LOW
+	Clock   uint64 `protobuf:"varint,5,opt,name=clock,proto3" json:"clock,omitempty"`     // same as in the ack
+	Epoch   uint64 `protobuf:"varint,6,opt,name=epoch,proto3" json:"epoch,omitempty"`     // same as in the ack
+	Minimum uint64 `protobuf:"varint,7,opt,name=minimum,proto3" json:"minimum,omitempty"` // the lowest bid that would have been accepted, set with BID_TOO_LOW
 }
 
-func (x *Amount) Reset() {
-	*x = Amount{}
+func (x *Failure) Reset() {
+	*x = Failure{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_das_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -87,13 +347,13 @@ func (x *Amount) Reset() {
 	}
 }
 
-func (x *Amount) String() string {
+func (x *Failure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Amount) ProtoMessage() {}
+func (*Failure) ProtoMessage() {}
 
-func (x *Amount) ProtoReflect() protoreflect.Message {
+func (x *Failure) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_das_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -105,37 +365,71 @@ func (x *Amount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Amount.ProtoReflect.Descriptor instead.
-func (*Amount) Descriptor() ([]byte, []int) {
+// Deprecated: Use Failure.ProtoReflect.Descriptor instead.
+func (*Failure) Descriptor() ([]byte, []int) {
 	return file_proto_das_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Amount) GetId() uint32 {
+func (x *Failure) GetReason() Reason {
 	if x != nil {
-		return x.Id
+		return x.Reason
+	}
+	return Reason_NO_REASON
+}
+
+func (x *Failure) GetAuction() string {
+	if x != nil {
+		return x.Auction
+	}
+	return ""
+}
+
+func (x *Failure) GetHighest() uint64 {
+	if x != nil {
+		return x.Highest
 	}
 	return 0
 }
 
-func (x *Amount) GetBid() uint64 {
+func (x *Failure) GetBidder() uint32 {
 	if x != nil {
-		return x.Bid
+		return x.Bidder
 	}
 	return 0
 }
 
-type Ack struct {
+func (x *Failure) GetClock() uint64 {
+	if x != nil {
+		return x.Clock
+	}
+	return 0
+}
+
+func (x *Failure) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *Failure) GetMinimum() uint64 {
+	if x != nil {
+		return x.Minimum
+	}
+	return 0
+}
+
+type Event struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response Acks `protobuf:"varint,1,opt,name=response,proto3,enum=proto.Acks" json:"response,omitempty"`
-	// fail / exception
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Kind    Events   `protobuf:"varint,1,opt,name=kind,proto3,enum=proto.Events" json:"kind,omitempty"`
+	Outcome *Outcome `protobuf:"bytes,2,opt,name=outcome,proto3" json:"outcome,omitempty"` // the auction after the event
 }
 
-func (x *Ack) Reset() {
-	*x = Ack{}
+func (x *Event) Reset() {
+	*x = Event{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_das_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -143,13 +437,13 @@ func (x *Ack) Reset() {
 	}
 }
 
-func (x *Ack) String() string {
+func (x *Event) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ack) ProtoMessage() {}
+func (*Event) ProtoMessage() {}
 
-func (x *Ack) ProtoReflect() protoreflect.Message {
+func (x *Event) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_das_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -161,33 +455,43 @@ func (x *Ack) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ack.ProtoReflect.Descriptor instead.
-func (*Ack) Descriptor() ([]byte, []int) {
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
 	return file_proto_das_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Ack) GetResponse() Acks {
+func (x *Event) GetKind() Events {
 	if x != nil {
-		return x.Response
+		return x.Kind
 	}
-	return Acks_FAIL
+	return Events_TICK
 }
 
-func (x *Ack) GetMessage() string {
+func (x *Event) GetOutcome() *Outcome {
 	if x != nil {
-		return x.Message
+		return x.Outcome
 	}
-	return ""
+	return nil
 }
 
-type Empty struct {
+type Amount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id      uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Bid     uint64 `protobuf:"varint,2,opt,name=bid,proto3" json:"bid,omitempty"`
+	Auction string `protobuf:"bytes,3,opt,name=auction,proto3" json:"auction,omitempty"` // id of the auction to bid on, empty is the last auction started
+	Clock   uint64 `protobuf:"varint,4,opt,name=clock,proto3" json:"clock,omitempty"`    // lamport timestamp of the client when it sent the bid
+	// idempotency key picked by the client, the same for every retry of a bid. a bid with the same id & key as one
+	// applied within DEDUPE_TTL is not applied again, it gets the ack of the first one - empty is never deduplicated
+	Request    string `protobuf:"bytes,5,opt,name=request,proto3" json:"request,omitempty"`
+	Commitment []byte `protobuf:"bytes,6,opt,name=commitment,proto3" json:"commitment,omitempty"` // hash of the bid, set with CommitBid - see the commitment package
+	Nonce      []byte `protobuf:"bytes,7,opt,name=nonce,proto3" json:"nonce,omitempty"`           // the nonce the commitment was made with, set with RevealBid
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
+func (x *Amount) Reset() {
+	*x = Amount{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_das_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -195,13 +499,13 @@ func (x *Empty) Reset() {
 	}
 }
 
-func (x *Empty) String() string {
+func (x *Amount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*Amount) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
+func (x *Amount) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_das_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -213,109 +517,96 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
+// Deprecated: Use Amount.ProtoReflect.Descriptor instead.
+func (*Amount) Descriptor() ([]byte, []int) {
 	return file_proto_das_proto_rawDescGZIP(), []int{2}
 }
 
-type Outcome struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Left   uint32 `protobuf:"varint,1,opt,name=left,proto3" json:"left,omitempty"`     // how many milliseconds are left before auction ends
-	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // highest bid
-	Bidder uint32 `protobuf:"varint,3,opt,name=bidder,proto3" json:"bidder,omitempty"` // id of highest bid, 0 is no bidder
-	Item   string `protobuf:"bytes,4,opt,name=item,proto3" json:"item,omitempty"`      // name of item we are bidding on
-}
-
-func (x *Outcome) Reset() {
-	*x = Outcome{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_das_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Amount) GetId() uint32 {
+	if x != nil {
+		return x.Id
 	}
+	return 0
 }
 
-func (x *Outcome) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Outcome) ProtoMessage() {}
-
-func (x *Outcome) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_das_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Amount) GetBid() uint64 {
+	if x != nil {
+		return x.Bid
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Outcome.ProtoReflect.Descriptor instead.
-func (*Outcome) Descriptor() ([]byte, []int) {
-	return file_proto_das_proto_rawDescGZIP(), []int{3}
+func (x *Amount) GetAuction() string {
+	if x != nil {
+		return x.Auction
+	}
+	return ""
 }
 
-func (x *Outcome) GetLeft() uint32 {
+func (x *Amount) GetClock() uint64 {
 	if x != nil {
-		return x.Left
+		return x.Clock
 	}
 	return 0
 }
 
-func (x *Outcome) GetAmount() uint64 {
+func (x *Amount) GetRequest() string {
 	if x != nil {
-		return x.Amount
+		return x.Request
 	}
-	return 0
+	return ""
 }
 
-func (x *Outcome) GetBidder() uint32 {
+func (x *Amount) GetCommitment() []byte {
 	if x != nil {
-		return x.Bidder
+		return x.Commitment
 	}
-	return 0
+	return nil
 }
 
-func (x *Outcome) GetItem() string {
+func (x *Amount) GetNonce() []byte {
 	if x != nil {
-		return x.Item
+		return x.Nonce
 	}
-	return ""
+	return nil
 }
 
-type Item struct {
+type Ack struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Start uint64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"` // starting bid, can be thought of as the minimum the client would accept
-	Alive uint32 `protobuf:"varint,3,opt,name=alive,proto3" json:"alive,omitempty"` // how many milliseconds the auction should last
+	Response Acks `protobuf:"varint,1,opt,name=response,proto3,enum=proto.Acks" json:"response,omitempty"`
+	// fail / exception
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Leader  string `protobuf:"bytes,3,opt,name=leader,proto3" json:"leader,omitempty"`                    // address of the leader, set when response is REDIRECT
+	Auction string `protobuf:"bytes,4,opt,name=auction,proto3" json:"auction,omitempty"`                  // id of the auction, set when an auction is started
+	Clock   uint64 `protobuf:"varint,5,opt,name=clock,proto3" json:"clock,omitempty"`                     // lamport timestamp of the entry the request was applied as, or of the replica when it redirected
+	Epoch   uint64 `protobuf:"varint,6,opt,name=epoch,proto3" json:"epoch,omitempty"`                     // membership epoch of the replica, see Membership
+	Reason  Reason `protobuf:"varint,7,opt,name=reason,proto3,enum=proto.Reason" json:"reason,omitempty"` // set with FAIL & EXCEPTION
+	Highest uint64 `protobuf:"varint,8,opt,name=highest,proto3" json:"highest,omitempty"`                 // the highest bid, set with BID_TOO_LOW
+	Bidder  uint32 `protobuf:"varint,9,opt,name=bidder,proto3" json:"bidder,omitempty"`                   // id of the highest bidder, set with BID_TOO_LOW
+	Minimum uint64 `protobuf:"varint,10,opt,name=minimum,proto3" json:"minimum,omitempty"`                // the lowest bid that would have been accepted, set with BID_TOO_LOW
+	Won     bool   `protobuf:"varint,11,opt,name=won,proto3" json:"won,omitempty"`                        // set with SUCCESS when the bid won the auction outright, it is over - highest & bidder are set too
 }
 
-func (x *Item) Reset() {
-	*x = Item{}
+func (x *Ack) Reset() {
+	*x = Ack{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_das_proto_msgTypes[4]
+		mi := &file_proto_das_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Item) String() string {
+func (x *Ack) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Item) ProtoMessage() {}
+func (*Ack) ProtoMessage() {}
 
-func (x *Item) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_das_proto_msgTypes[4]
+func (x *Ack) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -326,73 +617,2243 @@ func (x *Item) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Item.ProtoReflect.Descriptor instead.
-func (*Item) Descriptor() ([]byte, []int) {
-	return file_proto_das_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Ack.ProtoReflect.Descriptor instead.
+func (*Ack) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Item) GetName() string {
+func (x *Ack) GetResponse() Acks {
+	if x != nil {
+		return x.Response
+	}
+	return Acks_FAIL
+}
+
+func (x *Ack) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Ack) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+func (x *Ack) GetAuction() string {
+	if x != nil {
+		return x.Auction
+	}
+	return ""
+}
+
+func (x *Ack) GetClock() uint64 {
+	if x != nil {
+		return x.Clock
+	}
+	return 0
+}
+
+func (x *Ack) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *Ack) GetReason() Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return Reason_NO_REASON
+}
+
+func (x *Ack) GetHighest() uint64 {
+	if x != nil {
+		return x.Highest
+	}
+	return 0
+}
+
+func (x *Ack) GetBidder() uint32 {
+	if x != nil {
+		return x.Bidder
+	}
+	return 0
+}
+
+func (x *Ack) GetMinimum() uint64 {
+	if x != nil {
+		return x.Minimum
+	}
+	return 0
+}
+
+func (x *Ack) GetWon() bool {
+	if x != nil {
+		return x.Won
+	}
+	return false
+}
+
+type Query struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Auction string `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction,omitempty"` // id of the auction, empty is the last auction started
+}
+
+func (x *Query) Reset() {
+	*x = Query{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Query) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Query) ProtoMessage() {}
+
+func (x *Query) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Query.ProtoReflect.Descriptor instead.
+func (*Query) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Query) GetAuction() string {
+	if x != nil {
+		return x.Auction
+	}
+	return ""
+}
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{5}
+}
+
+type Outcome struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Left       uint32 `protobuf:"varint,1,opt,name=left,proto3" json:"left,omitempty"`                                // how many milliseconds are left before auction ends
+	Amount     uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                            // highest bid
+	Bidder     uint32 `protobuf:"varint,3,opt,name=bidder,proto3" json:"bidder,omitempty"`                            // id of highest bid, 0 is no bidder
+	Item       string `protobuf:"bytes,4,opt,name=item,proto3" json:"item,omitempty"`                                 // name of item we are bidding on
+	Leader     string `protobuf:"bytes,5,opt,name=leader,proto3" json:"leader,omitempty"`                             // set when the replica is a follower, the request has to be sent to this address instead
+	Auction    string `protobuf:"bytes,6,opt,name=auction,proto3" json:"auction,omitempty"`                           // id of the auction
+	Clock      uint64 `protobuf:"varint,7,opt,name=clock,proto3" json:"clock,omitempty"`                              // lamport timestamp of the last entry that changed the auction
+	Redirect   bool   `protobuf:"varint,8,opt,name=redirect,proto3" json:"redirect,omitempty"`                        // set when the replica could not answer, leader is who to ask instead - empty while it does not know
+	Epoch      uint64 `protobuf:"varint,9,opt,name=epoch,proto3" json:"epoch,omitempty"`                              // membership epoch of the replica, see Membership
+	Started    int64  `protobuf:"varint,10,opt,name=started,proto3" json:"started,omitempty"`                         // unix milliseconds of when the leader started the auction
+	Ends       int64  `protobuf:"varint,11,opt,name=ends,proto3" json:"ends,omitempty"`                               // unix milliseconds of when the auction is over
+	Minimum    uint64 `protobuf:"varint,12,opt,name=minimum,proto3" json:"minimum,omitempty"`                         // the lowest bid that will be accepted
+	Reserve    bool   `protobuf:"varint,13,opt,name=reserve,proto3" json:"reserve,omitempty"`                         // whether the auction has a reserve price, the price itself is never sent
+	ReserveMet bool   `protobuf:"varint,14,opt,name=reserve_met,json=reserveMet,proto3" json:"reserve_met,omitempty"` // whether the highest bid met the reserve, only set once the auction is over - it did not sell if not
+	Format     Format `protobuf:"varint,15,opt,name=format,proto3,enum=proto.Format" json:"format,omitempty"`
+	Price      uint64 `protobuf:"varint,16,opt,name=price,proto3" json:"price,omitempty"`                             // what the winner pays, only set once the auction is over - less than amount in a second price auction
+	ClockPrice uint64 `protobuf:"varint,17,opt,name=clock_price,json=clockPrice,proto3" json:"clock_price,omitempty"` // the price of a dutch auction right now, only set while it is live
+	RevealLeft uint32 `protobuf:"varint,18,opt,name=reveal_left,json=revealLeft,proto3" json:"reveal_left,omitempty"` // milliseconds left to reveal bids in, once an auction with reveal_for is over
+	BuyNow     uint64 `protobuf:"varint,19,opt,name=buy_now,json=buyNow,proto3" json:"buy_now,omitempty"`             // the buy it now price, 0 if there is none
+}
+
+func (x *Outcome) Reset() {
+	*x = Outcome{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Outcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Outcome) ProtoMessage() {}
+
+func (x *Outcome) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Outcome.ProtoReflect.Descriptor instead.
+func (*Outcome) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Outcome) GetLeft() uint32 {
+	if x != nil {
+		return x.Left
+	}
+	return 0
+}
+
+func (x *Outcome) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Outcome) GetBidder() uint32 {
+	if x != nil {
+		return x.Bidder
+	}
+	return 0
+}
+
+func (x *Outcome) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *Outcome) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+func (x *Outcome) GetAuction() string {
+	if x != nil {
+		return x.Auction
+	}
+	return ""
+}
+
+func (x *Outcome) GetClock() uint64 {
+	if x != nil {
+		return x.Clock
+	}
+	return 0
+}
+
+func (x *Outcome) GetRedirect() bool {
+	if x != nil {
+		return x.Redirect
+	}
+	return false
+}
+
+func (x *Outcome) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *Outcome) GetStarted() int64 {
+	if x != nil {
+		return x.Started
+	}
+	return 0
+}
+
+func (x *Outcome) GetEnds() int64 {
+	if x != nil {
+		return x.Ends
+	}
+	return 0
+}
+
+func (x *Outcome) GetMinimum() uint64 {
+	if x != nil {
+		return x.Minimum
+	}
+	return 0
+}
+
+func (x *Outcome) GetReserve() bool {
+	if x != nil {
+		return x.Reserve
+	}
+	return false
+}
+
+func (x *Outcome) GetReserveMet() bool {
+	if x != nil {
+		return x.ReserveMet
+	}
+	return false
+}
+
+func (x *Outcome) GetFormat() Format {
+	if x != nil {
+		return x.Format
+	}
+	return Format_OPEN
+}
+
+func (x *Outcome) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Outcome) GetClockPrice() uint64 {
+	if x != nil {
+		return x.ClockPrice
+	}
+	return 0
+}
+
+func (x *Outcome) GetRevealLeft() uint32 {
+	if x != nil {
+		return x.RevealLeft
+	}
+	return 0
+}
+
+func (x *Outcome) GetBuyNow() uint64 {
+	if x != nil {
+		return x.BuyNow
+	}
+	return 0
+}
+
+type Beat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"` // address of the replica sending the heartbeat, or answering it
+}
+
+func (x *Beat) Reset() {
+	*x = Beat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Beat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Beat) ProtoMessage() {}
+
+func (x *Beat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Beat.ProtoReflect.Descriptor instead.
+func (*Beat) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Beat) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+// what a replicas failure detector believes about each of its peers
+type HealthReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr     string        `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`     // the replica the report is from
+	Leader   string        `protobuf:"bytes,2,opt,name=leader,proto3" json:"leader,omitempty"` // who it believes is leader, empty while it does not know
+	Term     uint64        `protobuf:"varint,3,opt,name=term,proto3" json:"term,omitempty"`
+	Peers    []*PeerHealth `protobuf:"bytes,4,rep,name=peers,proto3" json:"peers,omitempty"`
+	Majority bool          `protobuf:"varint,5,opt,name=majority,proto3" json:"majority,omitempty"` // whether it, together with the peers it believes are alive, is a majority of the cluster
+}
+
+func (x *HealthReport) Reset() {
+	*x = HealthReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthReport) ProtoMessage() {}
+
+func (x *HealthReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthReport.ProtoReflect.Descriptor instead.
+func (*HealthReport) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *HealthReport) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *HealthReport) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+func (x *HealthReport) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *HealthReport) GetPeers() []*PeerHealth {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *HealthReport) GetMajority() bool {
+	if x != nil {
+		return x.Majority
+	}
+	return false
+}
+
+type PeerHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr     string  `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Alive    bool    `protobuf:"varint,2,opt,name=alive,proto3" json:"alive,omitempty"`
+	Phi      float64 `protobuf:"fixed64,3,opt,name=phi,proto3" json:"phi,omitempty"`                          // suspicion level, the peer is believed dead above PHI_THRESHOLD
+	LastSeen int64   `protobuf:"varint,4,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"` // milliseconds since the peer last answered a heartbeat, -1 if it never has
+}
+
+func (x *PeerHealth) Reset() {
+	*x = PeerHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerHealth) ProtoMessage() {}
+
+func (x *PeerHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerHealth.ProtoReflect.Descriptor instead.
+func (*PeerHealth) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PeerHealth) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *PeerHealth) GetAlive() bool {
+	if x != nil {
+		return x.Alive
+	}
+	return false
+}
+
+func (x *PeerHealth) GetPhi() float64 {
+	if x != nil {
+		return x.Phi
+	}
+	return 0
+}
+
+func (x *PeerHealth) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+type BidsQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Auction   string `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction,omitempty"`                      // id of the auction, empty is the last auction started
+	PageSize  uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // bids per page, 0 is DEFAULT_PAGE_SIZE - & it is never more than MAX_PAGE_SIZE
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the page before, empty is the first page
+}
+
+func (x *BidsQuery) Reset() {
+	*x = BidsQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BidsQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BidsQuery) ProtoMessage() {}
+
+func (x *BidsQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BidsQuery.ProtoReflect.Descriptor instead.
+func (*BidsQuery) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BidsQuery) GetAuction() string {
+	if x != nil {
+		return x.Auction
+	}
+	return ""
+}
+
+func (x *BidsQuery) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *BidsQuery) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type BidPage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Auction       string       `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction,omitempty"`
+	Bids          []*BidRecord `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids,omitempty"`
+	NextPageToken string       `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	Total         uint32       `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`                                       // bids placed on the auction in total
+	Leader        string       `protobuf:"bytes,5,opt,name=leader,proto3" json:"leader,omitempty"`                                      // set when the replica is a follower, the request has to be sent to this address instead
+	Redirect      bool         `protobuf:"varint,6,opt,name=redirect,proto3" json:"redirect,omitempty"`                                 // set when the replica could not answer, leader is who to ask instead - empty while it does not know
+	Epoch         uint64       `protobuf:"varint,7,opt,name=epoch,proto3" json:"epoch,omitempty"`                                       // membership epoch of the replica, see Membership
+}
+
+func (x *BidPage) Reset() {
+	*x = BidPage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BidPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BidPage) ProtoMessage() {}
+
+func (x *BidPage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BidPage.ProtoReflect.Descriptor instead.
+func (*BidPage) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BidPage) GetAuction() string {
+	if x != nil {
+		return x.Auction
+	}
+	return ""
+}
+
+func (x *BidPage) GetBids() []*BidRecord {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *BidPage) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *BidPage) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BidPage) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+func (x *BidPage) GetRedirect() bool {
+	if x != nil {
+		return x.Redirect
+	}
+	return false
+}
+
+func (x *BidPage) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type AuctionsQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter    Filter `protobuf:"varint,1,opt,name=filter,proto3,enum=proto.Filter" json:"filter,omitempty"`
+	Since     int64  `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`                         // only auctions started at or after this, in unix milliseconds - 0 is from the first one
+	Until     int64  `protobuf:"varint,3,opt,name=until,proto3" json:"until,omitempty"`                         // only auctions started before this, in unix milliseconds - 0 is until the last one
+	PageSize  uint32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // auctions per page, 0 is DEFAULT_PAGE_SIZE - & it is never more than MAX_PAGE_SIZE
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the page before, empty is the first page
+}
+
+func (x *AuctionsQuery) Reset() {
+	*x = AuctionsQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionsQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionsQuery) ProtoMessage() {}
+
+func (x *AuctionsQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionsQuery.ProtoReflect.Descriptor instead.
+func (*AuctionsQuery) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AuctionsQuery) GetFilter() Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return Filter_ALL
+}
+
+func (x *AuctionsQuery) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *AuctionsQuery) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *AuctionsQuery) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AuctionsQuery) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type AuctionPage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Auctions      []*Outcome `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	NextPageToken string     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	Leader        string     `protobuf:"bytes,3,opt,name=leader,proto3" json:"leader,omitempty"`                                      // set when the replica is a follower, the request has to be sent to this address instead
+	Redirect      bool       `protobuf:"varint,4,opt,name=redirect,proto3" json:"redirect,omitempty"`                                 // set when the replica could not answer, leader is who to ask instead - empty while it does not know
+	Epoch         uint64     `protobuf:"varint,5,opt,name=epoch,proto3" json:"epoch,omitempty"`                                       // membership epoch of the replica, see Membership
+}
+
+func (x *AuctionPage) Reset() {
+	*x = AuctionPage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionPage) ProtoMessage() {}
+
+func (x *AuctionPage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionPage.ProtoReflect.Descriptor instead.
+func (*AuctionPage) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AuctionPage) GetAuctions() []*Outcome {
+	if x != nil {
+		return x.Auctions
+	}
+	return nil
+}
+
+func (x *AuctionPage) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *AuctionPage) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+func (x *AuctionPage) GetRedirect() bool {
+	if x != nil {
+		return x.Redirect
+	}
+	return false
+}
+
+func (x *AuctionPage) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+// a bid placed on an auction, as it was applied
+type BidRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bidder     uint32 `protobuf:"varint,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Amount     uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Time       int64  `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`                       // unix milliseconds of when the leader appended the bid
+	Clock      uint64 `protobuf:"varint,4,opt,name=clock,proto3" json:"clock,omitempty"`                     // lamport timestamp of the bid in the log
+	Accepted   bool   `protobuf:"varint,5,opt,name=accepted,proto3" json:"accepted,omitempty"`               // whether it became the highest bid
+	Reason     Reason `protobuf:"varint,6,opt,name=reason,proto3,enum=proto.Reason" json:"reason,omitempty"` // why it was turned down, if it was
+	Request    string `protobuf:"bytes,7,opt,name=request,proto3" json:"request,omitempty"`                  // request key the client sent it with
+	Proxy      bool   `protobuf:"varint,8,opt,name=proxy,proto3" json:"proxy,omitempty"`                     // placed by the leader on the bidders behalf, up to their maximum bid
+	Commitment []byte `protobuf:"bytes,9,opt,name=commitment,proto3" json:"commitment,omitempty"`            // set when it is a commitment, the amount is not known until it is revealed
+	Reveal     bool   `protobuf:"varint,10,opt,name=reveal,proto3" json:"reveal,omitempty"`                  // set when it is the reveal of a commitment
+	Maximum    bool   `protobuf:"varint,11,opt,name=maximum,proto3" json:"maximum,omitempty"`                // set when it is a maximum bid being set, the amount is kept from the ledger - see SetMaxBid
+}
+
+func (x *BidRecord) Reset() {
+	*x = BidRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BidRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BidRecord) ProtoMessage() {}
+
+func (x *BidRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BidRecord.ProtoReflect.Descriptor instead.
+func (*BidRecord) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BidRecord) GetBidder() uint32 {
+	if x != nil {
+		return x.Bidder
+	}
+	return 0
+}
+
+func (x *BidRecord) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BidRecord) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *BidRecord) GetClock() uint64 {
+	if x != nil {
+		return x.Clock
+	}
+	return 0
+}
+
+func (x *BidRecord) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *BidRecord) GetReason() Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return Reason_NO_REASON
+}
+
+func (x *BidRecord) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *BidRecord) GetProxy() bool {
+	if x != nil {
+		return x.Proxy
+	}
+	return false
+}
+
+func (x *BidRecord) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *BidRecord) GetReveal() bool {
+	if x != nil {
+		return x.Reveal
+	}
+	return false
+}
+
+func (x *BidRecord) GetMaximum() bool {
+	if x != nil {
+		return x.Maximum
+	}
+	return false
+}
+
+type Member struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"` // address the replica is known by, to the other replicas & clients
+}
+
+func (x *Member) Reset() {
+	*x = Member{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Member) ProtoMessage() {}
+
+func (x *Member) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Member.ProtoReflect.Descriptor instead.
+func (*Member) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Member) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+// every replica in the cluster, the epoch goes up by one with every replica that joins or leaves.
+// epoch 0 is the peers each replica was started with, before anyone joined or left
+type Membership struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch    uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Members  []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	Leader   string   `protobuf:"bytes,3,opt,name=leader,proto3" json:"leader,omitempty"`      // set when the replica is a follower, the request has to be sent to this address instead
+	Redirect bool     `protobuf:"varint,4,opt,name=redirect,proto3" json:"redirect,omitempty"` // set when the replica could not answer, leader is who to ask instead - empty while it does not know
+}
+
+func (x *Membership) Reset() {
+	*x = Membership{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Membership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Membership) ProtoMessage() {}
+
+func (x *Membership) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Membership.ProtoReflect.Descriptor instead.
+func (*Membership) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Membership) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *Membership) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Membership) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+func (x *Membership) GetRedirect() bool {
+	if x != nil {
+		return x.Redirect
+	}
+	return false
+}
+
+type Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name             string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Start            uint64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`                                               // starting bid, can be thought of as the minimum the client would accept
+	Alive            uint32 `protobuf:"varint,3,opt,name=alive,proto3" json:"alive,omitempty"`                                               // how many milliseconds the auction should last
+	Clock            uint64 `protobuf:"varint,4,opt,name=clock,proto3" json:"clock,omitempty"`                                               // lamport timestamp of the client when it started the auction
+	ExtendWithin     uint32 `protobuf:"varint,5,opt,name=extend_within,json=extendWithin,proto3" json:"extend_within,omitempty"`             // a bid accepted this many milliseconds before the auction is over, extends it - 0 never does
+	ExtendBy         uint32 `protobuf:"varint,6,opt,name=extend_by,json=extendBy,proto3" json:"extend_by,omitempty"`                         // milliseconds the auction is extended by, each time
+	Increment        uint64 `protobuf:"varint,7,opt,name=increment,proto3" json:"increment,omitempty"`                                       // a bid has to be at least this much higher than the highest bid
+	IncrementPercent uint32 `protobuf:"varint,8,opt,name=increment_percent,json=incrementPercent,proto3" json:"increment_percent,omitempty"` // same, in percent of the highest bid - whichever is more is used
+	Reserve          uint64 `protobuf:"varint,9,opt,name=reserve,proto3" json:"reserve,omitempty"`                                           // the lowest price the item sells for, kept from bidders - 0 is no reserve
+	Format           Format `protobuf:"varint,10,opt,name=format,proto3,enum=proto.Format" json:"format,omitempty"`
+	Drop             uint64 `protobuf:"varint,11,opt,name=drop,proto3" json:"drop,omitempty"`                            // how much the price of a dutch auction drops each time, it never drops below the reserve
+	DropEvery        uint32 `protobuf:"varint,12,opt,name=drop_every,json=dropEvery,proto3" json:"drop_every,omitempty"` // milliseconds between each time the price drops
+	RevealFor        uint32 `protobuf:"varint,13,opt,name=reveal_for,json=revealFor,proto3" json:"reveal_for,omitempty"` // a sealed auction takes commitments instead of bids, & they are revealed for this many milliseconds after it is over
+	BuyNow           uint64 `protobuf:"varint,14,opt,name=buy_now,json=buyNow,proto3" json:"buy_now,omitempty"`          // a bid of at least this wins an open auction outright, & pays it - 0 is no buy it now price
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Item) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Item) GetStart() uint64 {
+func (x *Item) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Item) GetAlive() uint32 {
+	if x != nil {
+		return x.Alive
+	}
+	return 0
+}
+
+func (x *Item) GetClock() uint64 {
+	if x != nil {
+		return x.Clock
+	}
+	return 0
+}
+
+func (x *Item) GetExtendWithin() uint32 {
+	if x != nil {
+		return x.ExtendWithin
+	}
+	return 0
+}
+
+func (x *Item) GetExtendBy() uint32 {
+	if x != nil {
+		return x.ExtendBy
+	}
+	return 0
+}
+
+func (x *Item) GetIncrement() uint64 {
+	if x != nil {
+		return x.Increment
+	}
+	return 0
+}
+
+func (x *Item) GetIncrementPercent() uint32 {
+	if x != nil {
+		return x.IncrementPercent
+	}
+	return 0
+}
+
+func (x *Item) GetReserve() uint64 {
+	if x != nil {
+		return x.Reserve
+	}
+	return 0
+}
+
+func (x *Item) GetFormat() Format {
+	if x != nil {
+		return x.Format
+	}
+	return Format_OPEN
+}
+
+func (x *Item) GetDrop() uint64 {
+	if x != nil {
+		return x.Drop
+	}
+	return 0
+}
+
+func (x *Item) GetDropEvery() uint32 {
+	if x != nil {
+		return x.DropEvery
+	}
+	return 0
+}
+
+func (x *Item) GetRevealFor() uint32 {
+	if x != nil {
+		return x.RevealFor
+	}
+	return 0
+}
+
+func (x *Item) GetBuyNow() uint64 {
+	if x != nil {
+		return x.BuyNow
+	}
+	return 0
+}
+
+// a command in the replicated log, exactly one of bid, item, close, members, max_bid, commit & reveal is set - none set is a no-op
+type Entry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Term      uint64      `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"` // term of the leader that appended the entry
+	Time      int64       `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"` // unix milliseconds of when the leader appended the entry
+	Bid       *Amount     `protobuf:"bytes,3,opt,name=bid,proto3" json:"bid,omitempty"`
+	Item      *Item       `protobuf:"bytes,4,opt,name=item,proto3" json:"item,omitempty"`
+	Close     *Close      `protobuf:"bytes,5,opt,name=close,proto3" json:"close,omitempty"`
+	Auction   string      `protobuf:"bytes,6,opt,name=auction,proto3" json:"auction,omitempty"`                       // id the leader gave the auction started by item
+	Clock     uint64      `protobuf:"varint,7,opt,name=clock,proto3" json:"clock,omitempty"`                          // lamport timestamp the leader gave the entry, it increases with every entry in the log
+	Members   *Membership `protobuf:"bytes,8,opt,name=members,proto3" json:"members,omitempty"`                       // the cluster from this entry on, replicas use it as soon as it is in their log
+	MaxBid    *Amount     `protobuf:"bytes,9,opt,name=max_bid,json=maxBid,proto3" json:"max_bid,omitempty"`           // the most the bidder will pay, see SetMaxBid
+	Commit    *Amount     `protobuf:"bytes,10,opt,name=commit,proto3" json:"commit,omitempty"`                        // see CommitBid
+	Reveal    *Amount     `protobuf:"bytes,11,opt,name=reveal,proto3" json:"reveal,omitempty"`                        // see RevealBid
+	RevealEnd *Close      `protobuf:"bytes,12,opt,name=reveal_end,json=revealEnd,proto3" json:"reveal_end,omitempty"` // ends the time to reveal bids on an auction that is over, see RevealBid
+}
+
+func (x *Entry) Reset() {
+	*x = Entry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entry) ProtoMessage() {}
+
+func (x *Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entry.ProtoReflect.Descriptor instead.
+func (*Entry) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Entry) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *Entry) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Entry) GetBid() *Amount {
+	if x != nil {
+		return x.Bid
+	}
+	return nil
+}
+
+func (x *Entry) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *Entry) GetClose() *Close {
+	if x != nil {
+		return x.Close
+	}
+	return nil
+}
+
+func (x *Entry) GetAuction() string {
+	if x != nil {
+		return x.Auction
+	}
+	return ""
+}
+
+func (x *Entry) GetClock() uint64 {
+	if x != nil {
+		return x.Clock
+	}
+	return 0
+}
+
+func (x *Entry) GetMembers() *Membership {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Entry) GetMaxBid() *Amount {
+	if x != nil {
+		return x.MaxBid
+	}
+	return nil
+}
+
+func (x *Entry) GetCommit() *Amount {
+	if x != nil {
+		return x.Commit
+	}
+	return nil
+}
+
+func (x *Entry) GetReveal() *Amount {
+	if x != nil {
+		return x.Reveal
+	}
+	return nil
+}
+
+func (x *Entry) GetRevealEnd() *Close {
+	if x != nil {
+		return x.RevealEnd
+	}
+	return nil
+}
+
+type Close struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Auction string `protobuf:"bytes,2,opt,name=auction,proto3" json:"auction,omitempty"` // id of the auction that is over
+}
+
+func (x *Close) Reset() {
+	*x = Close{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Close) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Close) ProtoMessage() {}
+
+func (x *Close) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Close.ProtoReflect.Descriptor instead.
+func (*Close) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Close) GetAuction() string {
+	if x != nil {
+		return x.Auction
+	}
+	return ""
+}
+
+type Vote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Term      uint64 `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	Candidate string `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	LastIndex uint64 `protobuf:"varint,3,opt,name=last_index,json=lastIndex,proto3" json:"last_index,omitempty"` // index of the candidates last log entry
+	LastTerm  uint64 `protobuf:"varint,4,opt,name=last_term,json=lastTerm,proto3" json:"last_term,omitempty"`    // term of the candidates last log entry
+}
+
+func (x *Vote) Reset() {
+	*x = Vote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vote) ProtoMessage() {}
+
+func (x *Vote) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vote.ProtoReflect.Descriptor instead.
+func (*Vote) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Vote) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *Vote) GetCandidate() string {
+	if x != nil {
+		return x.Candidate
+	}
+	return ""
+}
+
+func (x *Vote) GetLastIndex() uint64 {
+	if x != nil {
+		return x.LastIndex
+	}
+	return 0
+}
+
+func (x *Vote) GetLastTerm() uint64 {
+	if x != nil {
+		return x.LastTerm
+	}
+	return 0
+}
+
+type VoteReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Term    uint64 `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	Granted bool   `protobuf:"varint,2,opt,name=granted,proto3" json:"granted,omitempty"`
+}
+
+func (x *VoteReply) Reset() {
+	*x = VoteReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoteReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteReply) ProtoMessage() {}
+
+func (x *VoteReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteReply.ProtoReflect.Descriptor instead.
+func (*VoteReply) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *VoteReply) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *VoteReply) GetGranted() bool {
+	if x != nil {
+		return x.Granted
+	}
+	return false
+}
+
+type Entries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Term      uint64   `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	Leader    string   `protobuf:"bytes,2,opt,name=leader,proto3" json:"leader,omitempty"`
+	PrevIndex uint64   `protobuf:"varint,3,opt,name=prev_index,json=prevIndex,proto3" json:"prev_index,omitempty"` // index of the entry preceding entries
+	PrevTerm  uint64   `protobuf:"varint,4,opt,name=prev_term,json=prevTerm,proto3" json:"prev_term,omitempty"`    // term of the entry preceding entries
+	Entries   []*Entry `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries,omitempty"`
+	Commit    uint64   `protobuf:"varint,6,opt,name=commit,proto3" json:"commit,omitempty"` // the leaders commit index
+}
+
+func (x *Entries) Reset() {
+	*x = Entries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Entries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entries) ProtoMessage() {}
+
+func (x *Entries) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entries.ProtoReflect.Descriptor instead.
+func (*Entries) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Entries) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *Entries) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+func (x *Entries) GetPrevIndex() uint64 {
+	if x != nil {
+		return x.PrevIndex
+	}
+	return 0
+}
+
+func (x *Entries) GetPrevTerm() uint64 {
+	if x != nil {
+		return x.PrevTerm
+	}
+	return 0
+}
+
+func (x *Entries) GetEntries() []*Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *Entries) GetCommit() uint64 {
+	if x != nil {
+		return x.Commit
+	}
+	return 0
+}
+
+type EntriesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Term      uint64 `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	Success   bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	LastIndex uint64 `protobuf:"varint,3,opt,name=last_index,json=lastIndex,proto3" json:"last_index,omitempty"` // index of the followers last log entry, lets the leader skip back quickly on failure
+}
+
+func (x *EntriesReply) Reset() {
+	*x = EntriesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntriesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntriesReply) ProtoMessage() {}
+
+func (x *EntriesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntriesReply.ProtoReflect.Descriptor instead.
+func (*EntriesReply) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *EntriesReply) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *EntriesReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EntriesReply) GetLastIndex() uint64 {
+	if x != nil {
+		return x.LastIndex
+	}
+	return 0
+}
+
+// the state of every auction as of some log entry, also what snapshots are saved to disk as
+type Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Term      uint64 `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`                            // term of the leader sending it, not used on disk
+	Leader    string `protobuf:"bytes,2,opt,name=leader,proto3" json:"leader,omitempty"`                         // address of the leader sending it, not used on disk
+	LastIndex uint64 `protobuf:"varint,3,opt,name=last_index,json=lastIndex,proto3" json:"last_index,omitempty"` // index of the last entry the snapshot includes
+	LastTerm  uint64 `protobuf:"varint,4,opt,name=last_term,json=lastTerm,proto3" json:"last_term,omitempty"`    // term of the last entry the snapshot includes
+	State     []byte `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`                           // the encoded state machine
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Snapshot) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *Snapshot) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+func (x *Snapshot) GetLastIndex() uint64 {
+	if x != nil {
+		return x.LastIndex
+	}
+	return 0
+}
+
+func (x *Snapshot) GetLastTerm() uint64 {
+	if x != nil {
+		return x.LastTerm
+	}
+	return 0
+}
+
+func (x *Snapshot) GetState() []byte {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type Transfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Leader   string     `protobuf:"bytes,1,opt,name=leader,proto3" json:"leader,omitempty"`     // set when the replica is a follower, the transfer has to be requested from this address instead
+	Snapshot *Snapshot  `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"` // where the leaders log is at, without the state - it was sent with InstallSnapshot
+	Live     []*Outcome `protobuf:"bytes,3,rep,name=live,proto3" json:"live,omitempty"`         // every live auction - highest bid, bidder & time left
+}
+
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_das_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transfer) ProtoMessage() {}
+
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_das_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_proto_das_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Transfer) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+func (x *Transfer) GetSnapshot() *Snapshot {
 	if x != nil {
-		return x.Start
+		return x.Snapshot
 	}
-	return 0
+	return nil
 }
 
-func (x *Item) GetAlive() uint32 {
+func (x *Transfer) GetLive() []*Outcome {
 	if x != nil {
-		return x.Alive
+		return x.Live
 	}
-	return 0
+	return nil
 }
 
 var File_proto_das_proto protoreflect.FileDescriptor
 
 var file_proto_das_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x61, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2a, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x03, 0x62, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x03, 0x41, 0x63, 0x6b, 0x12, 0x27, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x6b, 0x73, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x07,
-	0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x61, 0x0a, 0x07, 0x4f, 0x75, 0x74, 0x63, 0x6f,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
-	0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x46, 0x0a, 0x04, 0x49, 0x74,
-	0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x61, 0x6c, 0x69,
-	0x76, 0x65, 0x2a, 0x2c, 0x0a, 0x04, 0x41, 0x63, 0x6b, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x41,
-	0x49, 0x4c, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10,
-	0x01, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x58, 0x43, 0x45, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02,
-	0x32, 0x9c, 0x01, 0x0a, 0x03, 0x44, 0x41, 0x53, 0x12, 0x20, 0x0a, 0x03, 0x42, 0x69, 0x64, 0x12,
+	0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x01, 0x0a, 0x07, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x22, 0x54, 0x0a,
+	0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x6f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x62, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x62, 0x69, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x22, 0xab, 0x02, 0x0a, 0x03, 0x41, 0x63, 0x6b, 0x12, 0x27, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x41, 0x63, 0x6b, 0x73, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x25, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69,
+	0x64, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x62, 0x69, 0x64, 0x64,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03,
+	0x77, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x77, 0x6f, 0x6e, 0x22, 0x21,
+	0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xf6, 0x03, 0x0a, 0x07, 0x4f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
+	0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4d, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63,
+	0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76,
+	0x65, 0x61, 0x6c, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x75,
+	0x79, 0x5f, 0x6e, 0x6f, 0x77, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x75, 0x79,
+	0x4e, 0x6f, 0x77, 0x22, 0x1a, 0x0a, 0x04, 0x42, 0x65, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22,
+	0x93, 0x01, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d,
+	0x12, 0x27, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x6a,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x61, 0x6a,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x65, 0x0a, 0x0a, 0x50, 0x65, 0x65, 0x72, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x70, 0x68, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x68, 0x69, 0x12,
+	0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x22, 0x61, 0x0a, 0x09,
+	0x42, 0x69, 0x64, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xd1, 0x01, 0x0a, 0x07, 0x42, 0x69, 0x64, 0x50, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x69, 0x64, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x22, 0x9e, 0x01, 0x0a, 0x0d, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xab, 0x01, 0x0a, 0x0b, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x08, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x22, 0xaa, 0x02, 0x0a, 0x09, 0x42, 0x69, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72,
+	0x65, 0x76, 0x65, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x22,
+	0x1c, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x22, 0x70, 0x0a,
+	0x0a, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x22,
+	0x95, 0x03, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x69, 0x74,
+	0x68, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x42, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2b,
+	0x0a, 0x11, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x72, 0x6f, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x64, 0x72, 0x6f, 0x70,
+	0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x65, 0x76, 0x65, 0x72, 0x79, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x64, 0x72, 0x6f, 0x70, 0x45, 0x76, 0x65, 0x72, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x46, 0x6f, 0x72, 0x12, 0x17,
+	0x0a, 0x07, 0x62, 0x75, 0x79, 0x5f, 0x6e, 0x6f, 0x77, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x62, 0x75, 0x79, 0x4e, 0x6f, 0x77, 0x22, 0x95, 0x03, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x03, 0x62, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x03, 0x62, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x22, 0x0a, 0x05, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x07,
+	0x6d, 0x61, 0x78, 0x5f, 0x62, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x6d, 0x61,
+	0x78, 0x42, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x06, 0x72,
+	0x65, 0x76, 0x65, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x65, 0x76, 0x65,
+	0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x65, 0x6e, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x45, 0x6e, 0x64, 0x22,
+	0x27, 0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x74, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x74, 0x65, 0x72, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x22, 0x39,
+	0x0a, 0x09, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x07, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x65, 0x76, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x26, 0x0a,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x5b, 0x0a,
+	0x0c, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x65, 0x72,
+	0x6d, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x88, 0x01, 0x0a, 0x08, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x73, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x2a, 0x3a, 0x0a, 0x04, 0x41, 0x63,
+	0x6b, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x58, 0x43,
+	0x45, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x44, 0x49,
+	0x52, 0x45, 0x43, 0x54, 0x10, 0x03, 0x2a, 0xaa, 0x02, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x49, 0x44, 0x5f, 0x54, 0x4f, 0x4f, 0x5f,
+	0x4c, 0x4f, 0x57, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x5f, 0x41, 0x55, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x54, 0x5f, 0x55, 0x4e, 0x49,
+	0x51, 0x55, 0x45, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x5f, 0x4d, 0x41, 0x4a, 0x4f,
+	0x52, 0x49, 0x54, 0x59, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x5f,
+	0x4f, 0x55, 0x54, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x07, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x45, 0x4d,
+	0x42, 0x45, 0x52, 0x53, 0x48, 0x49, 0x50, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x49, 0x4e, 0x47,
+	0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45,
+	0x52, 0x10, 0x09, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x41, 0x44, 0x5f, 0x50, 0x41, 0x47, 0x45, 0x5f,
+	0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x0a, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x4c, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x5f, 0x42, 0x49, 0x44, 0x10, 0x0b, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x54, 0x5f,
+	0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x11, 0x0a, 0x0d, 0x4e,
+	0x4f, 0x54, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x41, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x0d, 0x12, 0x12,
+	0x0a, 0x0e, 0x42, 0x41, 0x44, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54,
+	0x10, 0x0e, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x41, 0x44, 0x5f, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x0f, 0x2a, 0x32, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x08, 0x0a,
+	0x04, 0x54, 0x49, 0x43, 0x4b, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x49, 0x44, 0x10, 0x01,
+	0x12, 0x09, 0x0a, 0x05, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x52,
+	0x45, 0x56, 0x45, 0x41, 0x4c, 0x10, 0x03, 0x2a, 0x40, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x46,
+	0x49, 0x52, 0x53, 0x54, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c,
+	0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x10, 0x02, 0x12, 0x09,
+	0x0a, 0x05, 0x44, 0x55, 0x54, 0x43, 0x48, 0x10, 0x03, 0x2a, 0x3d, 0x0a, 0x06, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x4c, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x53, 0x4f, 0x4c, 0x44, 0x10, 0x03, 0x12, 0x08,
+	0x0a, 0x04, 0x53, 0x4f, 0x4c, 0x44, 0x10, 0x04, 0x32, 0xf9, 0x06, 0x0a, 0x03, 0x44, 0x41, 0x53,
+	0x12, 0x20, 0x0a, 0x03, 0x42, 0x69, 0x64, 0x12, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x63, 0x6b, 0x12, 0x26, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x42, 0x69, 0x64, 0x12,
+	0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x0a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x09, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x42, 0x69, 0x64, 0x12, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x63, 0x6b, 0x12, 0x26, 0x0a, 0x09, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x42, 0x69, 0x64, 0x12,
 	0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x0a,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x06, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f,
 	0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x75, 0x63, 0x74, 0x69,
 	0x6f, 0x6e, 0x12, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x1a,
 	0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x6b, 0x12, 0x22, 0x0a, 0x04, 0x50,
 	0x69, 0x6e, 0x67, 0x12, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42,
-	0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x53, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x44, 0x69, 0x73,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x2d, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x2c, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x0c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x2c, 0x0a,
+	0x08, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x64, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x42, 0x69, 0x64, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x0e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x69, 0x64, 0x50, 0x61, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x12, 0x28, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x1a,
+	0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x6b, 0x12, 0x29, 0x0a, 0x0c, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x1a, 0x0a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x41, 0x63, 0x6b, 0x12, 0x2a, 0x0a, 0x07, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x12, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x12, 0x2b, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x0c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x2c, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x0b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x1a, 0x10, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a,
+	0x0d, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x0e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x13,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x0d,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x0d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x1a, 0x0f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x25, 0x0a,
+	0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x0b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x42, 0x65, 0x61, 0x74, 0x1a, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x42, 0x65, 0x61, 0x74, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x53, 0x70, 0x61, 0x63,
+	0x65, 0x2f, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x2d, 0x41, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -407,31 +2868,110 @@ func file_proto_das_proto_rawDescGZIP() []byte {
 	return file_proto_das_proto_rawDescData
 }
 
-var file_proto_das_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_das_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_das_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_proto_das_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_proto_das_proto_goTypes = []interface{}{
-	(Acks)(0),       // 0: proto.Acks
-	(*Amount)(nil),  // 1: proto.Amount
-	(*Ack)(nil),     // 2: proto.Ack
-	(*Empty)(nil),   // 3: proto.Empty
-	(*Outcome)(nil), // 4: proto.Outcome
-	(*Item)(nil),    // 5: proto.Item
+	(Acks)(0),             // 0: proto.Acks
+	(Reason)(0),           // 1: proto.Reason
+	(Events)(0),           // 2: proto.Events
+	(Format)(0),           // 3: proto.Format
+	(Filter)(0),           // 4: proto.Filter
+	(*Failure)(nil),       // 5: proto.Failure
+	(*Event)(nil),         // 6: proto.Event
+	(*Amount)(nil),        // 7: proto.Amount
+	(*Ack)(nil),           // 8: proto.Ack
+	(*Query)(nil),         // 9: proto.Query
+	(*Empty)(nil),         // 10: proto.Empty
+	(*Outcome)(nil),       // 11: proto.Outcome
+	(*Beat)(nil),          // 12: proto.Beat
+	(*HealthReport)(nil),  // 13: proto.HealthReport
+	(*PeerHealth)(nil),    // 14: proto.PeerHealth
+	(*BidsQuery)(nil),     // 15: proto.BidsQuery
+	(*BidPage)(nil),       // 16: proto.BidPage
+	(*AuctionsQuery)(nil), // 17: proto.AuctionsQuery
+	(*AuctionPage)(nil),   // 18: proto.AuctionPage
+	(*BidRecord)(nil),     // 19: proto.BidRecord
+	(*Member)(nil),        // 20: proto.Member
+	(*Membership)(nil),    // 21: proto.Membership
+	(*Item)(nil),          // 22: proto.Item
+	(*Entry)(nil),         // 23: proto.Entry
+	(*Close)(nil),         // 24: proto.Close
+	(*Vote)(nil),          // 25: proto.Vote
+	(*VoteReply)(nil),     // 26: proto.VoteReply
+	(*Entries)(nil),       // 27: proto.Entries
+	(*EntriesReply)(nil),  // 28: proto.EntriesReply
+	(*Snapshot)(nil),      // 29: proto.Snapshot
+	(*Transfer)(nil),      // 30: proto.Transfer
 }
 var file_proto_das_proto_depIdxs = []int32{
-	0, // 0: proto.Ack.response:type_name -> proto.Acks
-	1, // 1: proto.DAS.Bid:input_type -> proto.Amount
-	3, // 2: proto.DAS.Result:input_type -> proto.Empty
-	5, // 3: proto.DAS.StartAuction:input_type -> proto.Item
-	3, // 4: proto.DAS.Ping:input_type -> proto.Empty
-	2, // 5: proto.DAS.Bid:output_type -> proto.Ack
-	4, // 6: proto.DAS.Result:output_type -> proto.Outcome
-	2, // 7: proto.DAS.StartAuction:output_type -> proto.Ack
-	3, // 8: proto.DAS.Ping:output_type -> proto.Empty
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1,  // 0: proto.Failure.reason:type_name -> proto.Reason
+	2,  // 1: proto.Event.kind:type_name -> proto.Events
+	11, // 2: proto.Event.outcome:type_name -> proto.Outcome
+	0,  // 3: proto.Ack.response:type_name -> proto.Acks
+	1,  // 4: proto.Ack.reason:type_name -> proto.Reason
+	3,  // 5: proto.Outcome.format:type_name -> proto.Format
+	14, // 6: proto.HealthReport.peers:type_name -> proto.PeerHealth
+	19, // 7: proto.BidPage.bids:type_name -> proto.BidRecord
+	4,  // 8: proto.AuctionsQuery.filter:type_name -> proto.Filter
+	11, // 9: proto.AuctionPage.auctions:type_name -> proto.Outcome
+	1,  // 10: proto.BidRecord.reason:type_name -> proto.Reason
+	3,  // 11: proto.Item.format:type_name -> proto.Format
+	7,  // 12: proto.Entry.bid:type_name -> proto.Amount
+	22, // 13: proto.Entry.item:type_name -> proto.Item
+	24, // 14: proto.Entry.close:type_name -> proto.Close
+	21, // 15: proto.Entry.members:type_name -> proto.Membership
+	7,  // 16: proto.Entry.max_bid:type_name -> proto.Amount
+	7,  // 17: proto.Entry.commit:type_name -> proto.Amount
+	7,  // 18: proto.Entry.reveal:type_name -> proto.Amount
+	24, // 19: proto.Entry.reveal_end:type_name -> proto.Close
+	23, // 20: proto.Entries.entries:type_name -> proto.Entry
+	29, // 21: proto.Transfer.snapshot:type_name -> proto.Snapshot
+	11, // 22: proto.Transfer.live:type_name -> proto.Outcome
+	7,  // 23: proto.DAS.Bid:input_type -> proto.Amount
+	7,  // 24: proto.DAS.SetMaxBid:input_type -> proto.Amount
+	7,  // 25: proto.DAS.CommitBid:input_type -> proto.Amount
+	7,  // 26: proto.DAS.RevealBid:input_type -> proto.Amount
+	9,  // 27: proto.DAS.Result:input_type -> proto.Query
+	22, // 28: proto.DAS.StartAuction:input_type -> proto.Item
+	10, // 29: proto.DAS.Ping:input_type -> proto.Empty
+	9,  // 30: proto.DAS.WatchAuction:input_type -> proto.Query
+	15, // 31: proto.DAS.ListBids:input_type -> proto.BidsQuery
+	17, // 32: proto.DAS.ListAuctions:input_type -> proto.AuctionsQuery
+	9,  // 33: proto.DAS.GetAuction:input_type -> proto.Query
+	20, // 34: proto.DAS.JoinCluster:input_type -> proto.Member
+	20, // 35: proto.DAS.LeaveCluster:input_type -> proto.Member
+	10, // 36: proto.DAS.Members:input_type -> proto.Empty
+	10, // 37: proto.DAS.Health:input_type -> proto.Empty
+	25, // 38: proto.DAS.RequestVote:input_type -> proto.Vote
+	27, // 39: proto.DAS.AppendEntries:input_type -> proto.Entries
+	29, // 40: proto.DAS.InstallSnapshot:input_type -> proto.Snapshot
+	20, // 41: proto.DAS.StateTransfer:input_type -> proto.Member
+	12, // 42: proto.DAS.Heartbeat:input_type -> proto.Beat
+	8,  // 43: proto.DAS.Bid:output_type -> proto.Ack
+	8,  // 44: proto.DAS.SetMaxBid:output_type -> proto.Ack
+	8,  // 45: proto.DAS.CommitBid:output_type -> proto.Ack
+	8,  // 46: proto.DAS.RevealBid:output_type -> proto.Ack
+	11, // 47: proto.DAS.Result:output_type -> proto.Outcome
+	8,  // 48: proto.DAS.StartAuction:output_type -> proto.Ack
+	10, // 49: proto.DAS.Ping:output_type -> proto.Empty
+	6,  // 50: proto.DAS.WatchAuction:output_type -> proto.Event
+	16, // 51: proto.DAS.ListBids:output_type -> proto.BidPage
+	18, // 52: proto.DAS.ListAuctions:output_type -> proto.AuctionPage
+	11, // 53: proto.DAS.GetAuction:output_type -> proto.Outcome
+	8,  // 54: proto.DAS.JoinCluster:output_type -> proto.Ack
+	8,  // 55: proto.DAS.LeaveCluster:output_type -> proto.Ack
+	21, // 56: proto.DAS.Members:output_type -> proto.Membership
+	13, // 57: proto.DAS.Health:output_type -> proto.HealthReport
+	26, // 58: proto.DAS.RequestVote:output_type -> proto.VoteReply
+	28, // 59: proto.DAS.AppendEntries:output_type -> proto.EntriesReply
+	28, // 60: proto.DAS.InstallSnapshot:output_type -> proto.EntriesReply
+	30, // 61: proto.DAS.StateTransfer:output_type -> proto.Transfer
+	12, // 62: proto.DAS.Heartbeat:output_type -> proto.Beat
+	43, // [43:63] is the sub-list for method output_type
+	23, // [23:43] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_proto_das_proto_init() }
@@ -441,7 +2981,7 @@ func file_proto_das_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_proto_das_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Amount); i {
+			switch v := v.(*Failure); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -453,7 +2993,7 @@ func file_proto_das_proto_init() {
 			}
 		}
 		file_proto_das_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ack); i {
+			switch v := v.(*Event); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -465,7 +3005,7 @@ func file_proto_das_proto_init() {
 			}
 		}
 		file_proto_das_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+			switch v := v.(*Amount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -477,7 +3017,7 @@ func file_proto_das_proto_init() {
 			}
 		}
 		file_proto_das_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Outcome); i {
+			switch v := v.(*Ack); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -489,6 +3029,162 @@ func file_proto_das_proto_init() {
 			}
 		}
 		file_proto_das_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Query); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Outcome); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Beat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BidsQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BidPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionsQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BidRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Member); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Membership); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Item); i {
 			case 0:
 				return &v.state
@@ -500,14 +3196,110 @@ func file_proto_das_proto_init() {
 				return nil
 			}
 		}
+		file_proto_das_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Close); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Vote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Entries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntriesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Snapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_das_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_das_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   5,
+			NumEnums:      5,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
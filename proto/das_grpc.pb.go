@@ -19,11 +19,43 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DASClient interface {
 	Bid(ctx context.Context, in *Amount, opts ...grpc.CallOption) (*Ack, error)
-	Result(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Outcome, error)
+	// bids on the clients behalf up to the amount, whenever it is outbid - the amount is never sent to other clients
+	SetMaxBid(ctx context.Context, in *Amount, opts ...grpc.CallOption) (*Ack, error)
+	// commits to a bid on a sealed auction with reveal_for set, without sending the bid - & reveals it once the auction is over
+	CommitBid(ctx context.Context, in *Amount, opts ...grpc.CallOption) (*Ack, error)
+	RevealBid(ctx context.Context, in *Amount, opts ...grpc.CallOption) (*Ack, error)
+	Result(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Outcome, error)
 	// a client can tell the server it has something to sell
 	// this is how the active replicas get synced for auctions
 	StartAuction(ctx context.Context, in *Item, opts ...grpc.CallOption) (*Ack, error)
 	Ping(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	// pushes every accepted bid, the time left every second - & ends with the auction closing
+	WatchAuction(ctx context.Context, in *Query, opts ...grpc.CallOption) (DAS_WatchAuctionClient, error)
+	// every bid placed on an auction, accepted or not - oldest first, a page at a time
+	ListBids(ctx context.Context, in *BidsQuery, opts ...grpc.CallOption) (*BidPage, error)
+	// every auction started, live or over - oldest first, a page at a time
+	ListAuctions(ctx context.Context, in *AuctionsQuery, opts ...grpc.CallOption) (*AuctionPage, error)
+	// the auction with an id, unlike Result it never guesses which one
+	GetAuction(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Outcome, error)
+	// adds a replica to the cluster, & removes one from it - only the leader can, followers redirect
+	JoinCluster(ctx context.Context, in *Member, opts ...grpc.CallOption) (*Ack, error)
+	LeaveCluster(ctx context.Context, in *Member, opts ...grpc.CallOption) (*Ack, error)
+	// the replicas in the cluster, as of the last membership change
+	Members(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Membership, error)
+	// which peers the replica believes are alive, see Health
+	Health(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HealthReport, error)
+	// replica-to-replica, raft leader election
+	RequestVote(ctx context.Context, in *Vote, opts ...grpc.CallOption) (*VoteReply, error)
+	// replica-to-replica, raft log replication - with no entries it is the leaders heartbeat
+	AppendEntries(ctx context.Context, in *Entries, opts ...grpc.CallOption) (*EntriesReply, error)
+	// replica-to-replica, sent by the leader instead of entries it has already compacted into a snapshot
+	InstallSnapshot(ctx context.Context, in *Snapshot, opts ...grpc.CallOption) (*EntriesReply, error)
+	// replica-to-replica, a replica that is (re)joining the cluster pulls the leaders state, before it takes part.
+	// only answered for members of the cluster, & the state is sent to the members address with InstallSnapshot - it
+	// holds what bidders are not shown, maximum bids, reserves & sealed bids
+	StateTransfer(ctx context.Context, in *Member, opts ...grpc.CallOption) (*Transfer, error)
+	// replica-to-replica, sent to every peer by the failure detector - the replies are what it decides who is alive from
+	Heartbeat(ctx context.Context, in *Beat, opts ...grpc.CallOption) (*Beat, error)
 }
 
 type dASClient struct {
@@ -43,7 +75,34 @@ func (c *dASClient) Bid(ctx context.Context, in *Amount, opts ...grpc.CallOption
 	return out, nil
 }
 
-func (c *dASClient) Result(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Outcome, error) {
+func (c *dASClient) SetMaxBid(ctx context.Context, in *Amount, opts ...grpc.CallOption) (*Ack, error) {
+	out := new(Ack)
+	err := c.cc.Invoke(ctx, "/proto.DAS/SetMaxBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dASClient) CommitBid(ctx context.Context, in *Amount, opts ...grpc.CallOption) (*Ack, error) {
+	out := new(Ack)
+	err := c.cc.Invoke(ctx, "/proto.DAS/CommitBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dASClient) RevealBid(ctx context.Context, in *Amount, opts ...grpc.CallOption) (*Ack, error) {
+	out := new(Ack)
+	err := c.cc.Invoke(ctx, "/proto.DAS/RevealBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dASClient) Result(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Outcome, error) {
 	out := new(Outcome)
 	err := c.cc.Invoke(ctx, "/proto.DAS/Result", in, out, opts...)
 	if err != nil {
@@ -70,16 +129,188 @@ func (c *dASClient) Ping(ctx context.Context, in *Empty, opts ...grpc.CallOption
 	return out, nil
 }
 
+func (c *dASClient) WatchAuction(ctx context.Context, in *Query, opts ...grpc.CallOption) (DAS_WatchAuctionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DAS_ServiceDesc.Streams[0], "/proto.DAS/WatchAuction", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dASWatchAuctionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DAS_WatchAuctionClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type dASWatchAuctionClient struct {
+	grpc.ClientStream
+}
+
+func (x *dASWatchAuctionClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dASClient) ListBids(ctx context.Context, in *BidsQuery, opts ...grpc.CallOption) (*BidPage, error) {
+	out := new(BidPage)
+	err := c.cc.Invoke(ctx, "/proto.DAS/ListBids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dASClient) ListAuctions(ctx context.Context, in *AuctionsQuery, opts ...grpc.CallOption) (*AuctionPage, error) {
+	out := new(AuctionPage)
+	err := c.cc.Invoke(ctx, "/proto.DAS/ListAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dASClient) GetAuction(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Outcome, error) {
+	out := new(Outcome)
+	err := c.cc.Invoke(ctx, "/proto.DAS/GetAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dASClient) JoinCluster(ctx context.Context, in *Member, opts ...grpc.CallOption) (*Ack, error) {
+	out := new(Ack)
+	err := c.cc.Invoke(ctx, "/proto.DAS/JoinCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dASClient) LeaveCluster(ctx context.Context, in *Member, opts ...grpc.CallOption) (*Ack, error) {
+	out := new(Ack)
+	err := c.cc.Invoke(ctx, "/proto.DAS/LeaveCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dASClient) Members(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Membership, error) {
+	out := new(Membership)
+	err := c.cc.Invoke(ctx, "/proto.DAS/Members", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dASClient) Health(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HealthReport, error) {
+	out := new(HealthReport)
+	err := c.cc.Invoke(ctx, "/proto.DAS/Health", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dASClient) RequestVote(ctx context.Context, in *Vote, opts ...grpc.CallOption) (*VoteReply, error) {
+	out := new(VoteReply)
+	err := c.cc.Invoke(ctx, "/proto.DAS/RequestVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dASClient) AppendEntries(ctx context.Context, in *Entries, opts ...grpc.CallOption) (*EntriesReply, error) {
+	out := new(EntriesReply)
+	err := c.cc.Invoke(ctx, "/proto.DAS/AppendEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dASClient) InstallSnapshot(ctx context.Context, in *Snapshot, opts ...grpc.CallOption) (*EntriesReply, error) {
+	out := new(EntriesReply)
+	err := c.cc.Invoke(ctx, "/proto.DAS/InstallSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dASClient) StateTransfer(ctx context.Context, in *Member, opts ...grpc.CallOption) (*Transfer, error) {
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, "/proto.DAS/StateTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dASClient) Heartbeat(ctx context.Context, in *Beat, opts ...grpc.CallOption) (*Beat, error) {
+	out := new(Beat)
+	err := c.cc.Invoke(ctx, "/proto.DAS/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DASServer is the server API for DAS service.
 // All implementations must embed UnimplementedDASServer
 // for forward compatibility
 type DASServer interface {
 	Bid(context.Context, *Amount) (*Ack, error)
-	Result(context.Context, *Empty) (*Outcome, error)
+	// bids on the clients behalf up to the amount, whenever it is outbid - the amount is never sent to other clients
+	SetMaxBid(context.Context, *Amount) (*Ack, error)
+	// commits to a bid on a sealed auction with reveal_for set, without sending the bid - & reveals it once the auction is over
+	CommitBid(context.Context, *Amount) (*Ack, error)
+	RevealBid(context.Context, *Amount) (*Ack, error)
+	Result(context.Context, *Query) (*Outcome, error)
 	// a client can tell the server it has something to sell
 	// this is how the active replicas get synced for auctions
 	StartAuction(context.Context, *Item) (*Ack, error)
 	Ping(context.Context, *Empty) (*Empty, error)
+	// pushes every accepted bid, the time left every second - & ends with the auction closing
+	WatchAuction(*Query, DAS_WatchAuctionServer) error
+	// every bid placed on an auction, accepted or not - oldest first, a page at a time
+	ListBids(context.Context, *BidsQuery) (*BidPage, error)
+	// every auction started, live or over - oldest first, a page at a time
+	ListAuctions(context.Context, *AuctionsQuery) (*AuctionPage, error)
+	// the auction with an id, unlike Result it never guesses which one
+	GetAuction(context.Context, *Query) (*Outcome, error)
+	// adds a replica to the cluster, & removes one from it - only the leader can, followers redirect
+	JoinCluster(context.Context, *Member) (*Ack, error)
+	LeaveCluster(context.Context, *Member) (*Ack, error)
+	// the replicas in the cluster, as of the last membership change
+	Members(context.Context, *Empty) (*Membership, error)
+	// which peers the replica believes are alive, see Health
+	Health(context.Context, *Empty) (*HealthReport, error)
+	// replica-to-replica, raft leader election
+	RequestVote(context.Context, *Vote) (*VoteReply, error)
+	// replica-to-replica, raft log replication - with no entries it is the leaders heartbeat
+	AppendEntries(context.Context, *Entries) (*EntriesReply, error)
+	// replica-to-replica, sent by the leader instead of entries it has already compacted into a snapshot
+	InstallSnapshot(context.Context, *Snapshot) (*EntriesReply, error)
+	// replica-to-replica, a replica that is (re)joining the cluster pulls the leaders state, before it takes part.
+	// only answered for members of the cluster, & the state is sent to the members address with InstallSnapshot - it
+	// holds what bidders are not shown, maximum bids, reserves & sealed bids
+	StateTransfer(context.Context, *Member) (*Transfer, error)
+	// replica-to-replica, sent to every peer by the failure detector - the replies are what it decides who is alive from
+	Heartbeat(context.Context, *Beat) (*Beat, error)
 	mustEmbedUnimplementedDASServer()
 }
 
@@ -90,7 +321,16 @@ type UnimplementedDASServer struct {
 func (UnimplementedDASServer) Bid(context.Context, *Amount) (*Ack, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Bid not implemented")
 }
-func (UnimplementedDASServer) Result(context.Context, *Empty) (*Outcome, error) {
+func (UnimplementedDASServer) SetMaxBid(context.Context, *Amount) (*Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxBid not implemented")
+}
+func (UnimplementedDASServer) CommitBid(context.Context, *Amount) (*Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitBid not implemented")
+}
+func (UnimplementedDASServer) RevealBid(context.Context, *Amount) (*Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealBid not implemented")
+}
+func (UnimplementedDASServer) Result(context.Context, *Query) (*Outcome, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Result not implemented")
 }
 func (UnimplementedDASServer) StartAuction(context.Context, *Item) (*Ack, error) {
@@ -99,6 +339,45 @@ func (UnimplementedDASServer) StartAuction(context.Context, *Item) (*Ack, error)
 func (UnimplementedDASServer) Ping(context.Context, *Empty) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
 }
+func (UnimplementedDASServer) WatchAuction(*Query, DAS_WatchAuctionServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAuction not implemented")
+}
+func (UnimplementedDASServer) ListBids(context.Context, *BidsQuery) (*BidPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBids not implemented")
+}
+func (UnimplementedDASServer) ListAuctions(context.Context, *AuctionsQuery) (*AuctionPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuctions not implemented")
+}
+func (UnimplementedDASServer) GetAuction(context.Context, *Query) (*Outcome, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuction not implemented")
+}
+func (UnimplementedDASServer) JoinCluster(context.Context, *Member) (*Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinCluster not implemented")
+}
+func (UnimplementedDASServer) LeaveCluster(context.Context, *Member) (*Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveCluster not implemented")
+}
+func (UnimplementedDASServer) Members(context.Context, *Empty) (*Membership, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Members not implemented")
+}
+func (UnimplementedDASServer) Health(context.Context, *Empty) (*HealthReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+func (UnimplementedDASServer) RequestVote(context.Context, *Vote) (*VoteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestVote not implemented")
+}
+func (UnimplementedDASServer) AppendEntries(context.Context, *Entries) (*EntriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendEntries not implemented")
+}
+func (UnimplementedDASServer) InstallSnapshot(context.Context, *Snapshot) (*EntriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallSnapshot not implemented")
+}
+func (UnimplementedDASServer) StateTransfer(context.Context, *Member) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StateTransfer not implemented")
+}
+func (UnimplementedDASServer) Heartbeat(context.Context, *Beat) (*Beat, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
 func (UnimplementedDASServer) mustEmbedUnimplementedDASServer() {}
 
 // UnsafeDASServer may be embedded to opt out of forward compatibility for this service.
@@ -130,8 +409,62 @@ func _DAS_Bid_Handler(srv interface{}, ctx context.Context, dec func(interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DAS_SetMaxBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Amount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).SetMaxBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DAS/SetMaxBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).SetMaxBid(ctx, req.(*Amount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DAS_CommitBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Amount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).CommitBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DAS/CommitBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).CommitBid(ctx, req.(*Amount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DAS_RevealBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Amount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).RevealBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DAS/RevealBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).RevealBid(ctx, req.(*Amount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DAS_Result_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(Query)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -143,7 +476,7 @@ func _DAS_Result_Handler(srv interface{}, ctx context.Context, dec func(interfac
 		FullMethod: "/proto.DAS/Result",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DASServer).Result(ctx, req.(*Empty))
+		return srv.(DASServer).Result(ctx, req.(*Query))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -184,6 +517,243 @@ func _DAS_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DAS_WatchAuction_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Query)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DASServer).WatchAuction(m, &dASWatchAuctionServer{stream})
+}
+
+type DAS_WatchAuctionServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type dASWatchAuctionServer struct {
+	grpc.ServerStream
+}
+
+func (x *dASWatchAuctionServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DAS_ListBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BidsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).ListBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DAS/ListBids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).ListBids(ctx, req.(*BidsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DAS_ListAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuctionsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).ListAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DAS/ListAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).ListAuctions(ctx, req.(*AuctionsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DAS_GetAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).GetAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DAS/GetAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).GetAuction(ctx, req.(*Query))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DAS_JoinCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Member)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).JoinCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DAS/JoinCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).JoinCluster(ctx, req.(*Member))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DAS_LeaveCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Member)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).LeaveCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DAS/LeaveCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).LeaveCluster(ctx, req.(*Member))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DAS_Members_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).Members(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DAS/Members",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).Members(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DAS_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DAS/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).Health(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DAS_RequestVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Vote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).RequestVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DAS/RequestVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).RequestVote(ctx, req.(*Vote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DAS_AppendEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Entries)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).AppendEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DAS/AppendEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).AppendEntries(ctx, req.(*Entries))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DAS_InstallSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Snapshot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).InstallSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DAS/InstallSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).InstallSnapshot(ctx, req.(*Snapshot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DAS_StateTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Member)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).StateTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DAS/StateTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).StateTransfer(ctx, req.(*Member))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DAS_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Beat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DAS/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).Heartbeat(ctx, req.(*Beat))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DAS_ServiceDesc is the grpc.ServiceDesc for DAS service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -195,6 +765,18 @@ var DAS_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Bid",
 			Handler:    _DAS_Bid_Handler,
 		},
+		{
+			MethodName: "SetMaxBid",
+			Handler:    _DAS_SetMaxBid_Handler,
+		},
+		{
+			MethodName: "CommitBid",
+			Handler:    _DAS_CommitBid_Handler,
+		},
+		{
+			MethodName: "RevealBid",
+			Handler:    _DAS_RevealBid_Handler,
+		},
 		{
 			MethodName: "Result",
 			Handler:    _DAS_Result_Handler,
@@ -207,7 +789,61 @@ var DAS_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Ping",
 			Handler:    _DAS_Ping_Handler,
 		},
+		{
+			MethodName: "ListBids",
+			Handler:    _DAS_ListBids_Handler,
+		},
+		{
+			MethodName: "ListAuctions",
+			Handler:    _DAS_ListAuctions_Handler,
+		},
+		{
+			MethodName: "GetAuction",
+			Handler:    _DAS_GetAuction_Handler,
+		},
+		{
+			MethodName: "JoinCluster",
+			Handler:    _DAS_JoinCluster_Handler,
+		},
+		{
+			MethodName: "LeaveCluster",
+			Handler:    _DAS_LeaveCluster_Handler,
+		},
+		{
+			MethodName: "Members",
+			Handler:    _DAS_Members_Handler,
+		},
+		{
+			MethodName: "Health",
+			Handler:    _DAS_Health_Handler,
+		},
+		{
+			MethodName: "RequestVote",
+			Handler:    _DAS_RequestVote_Handler,
+		},
+		{
+			MethodName: "AppendEntries",
+			Handler:    _DAS_AppendEntries_Handler,
+		},
+		{
+			MethodName: "InstallSnapshot",
+			Handler:    _DAS_InstallSnapshot_Handler,
+		},
+		{
+			MethodName: "StateTransfer",
+			Handler:    _DAS_StateTransfer_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _DAS_Heartbeat_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchAuction",
+			Handler:       _DAS_WatchAuction_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/das.proto",
 }
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+)
+
+// an open auction can have a buy it now price - the first bid at or above it wins the auction outright, & pays it.
+// the auction is ended by applying the bid, so every replica ends it at the same entry & the watchers are told right away.
+// bids placed up to a maximum bid buy it too, once they reach the price
+
+// returns whether a bid of amount wins the auction outright
+func (a *Auction) BuysNow(amount uint64) bool {
+	return a.buyNow > 0 && amount >= a.buyNow
+}
+
+// returns why an auction cannot be started with the buy it now price of the item, empty if it can
+func CheckBuyNow(item *DAS.Item) string {
+	switch {
+	case item.BuyNow == 0:
+		return ""
+	case item.BuyNow <= item.Start:
+		return "The buy it now price has to be above the starting bid"
+	case item.BuyNow <= item.Reserve:
+		return "The buy it now price has to be above the reserve"
+	}
+	return ""
+}
+
+// ends the auction at the buy it now price, once the highest bid has reached it
+func (s *State) buyNow(auction *Auction, now time.Time, clock uint64) *DAS.Ack {
+	log.Printf("Apply() | Bid from %v on '%v' at clock %v reached the buy it now price of %v\n", auction.bidder, auction.id, clock, auction.buyNow)
+	auction.highestBid = auction.buyNow
+	s.end(auction, now, clock)
+	return &DAS.Ack{
+		Response: DAS.Acks_SUCCESS,
+		Message:  "Bid won the auction outright, at the buy it now price",
+		Auction:  auction.id,
+		Highest:  auction.highestBid,
+		Bidder:   auction.bidder,
+		Won:      true,
+	}
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+)
+
+func TestBuyNow(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []*DAS.Entry
+		won     bool // whether the last entry was told it won
+		closed  bool
+		bidder  uint32
+	}{
+		{"a bid below the price does not", []*DAS.Entry{
+			at(2, 10, &DAS.Entry{Bid: &DAS.Amount{Id: 1, Bid: 499, Auction: "a"}}),
+		}, false, false, 1},
+		{"a bid at the price wins", []*DAS.Entry{
+			at(2, 10, &DAS.Entry{Bid: &DAS.Amount{Id: 1, Bid: 500, Auction: "a"}}),
+		}, true, true, 1},
+		{"a bid above the price wins, & pays the price", []*DAS.Entry{
+			at(2, 10, &DAS.Entry{Bid: &DAS.Amount{Id: 1, Bid: 900, Auction: "a"}}),
+		}, true, true, 1},
+		{"a maximum outbidding to the price wins, the bid it outbid is told it was too low", []*DAS.Entry{
+			at(2, 10, &DAS.Entry{MaxBid: &DAS.Amount{Id: 1, Bid: 1000, Auction: "a"}}),
+			at(3, 20, &DAS.Entry{Bid: &DAS.Amount{Id: 2, Bid: 498, Auction: "a"}}),
+		}, false, true, 1},
+	}
+	for _, test := range tests {
+		s := NewState()
+		s.Apply(at(1, 0, &DAS.Entry{Auction: "a", Item: &DAS.Item{Name: "a", Start: 10, Alive: 1000, Increment: 5, BuyNow: 500}}))
+		var ack *DAS.Ack
+		for _, entry := range test.entries {
+			ack = s.Apply(entry)
+		}
+		a := s.Get("a")
+		if ack.Won != test.won || a.closed != test.closed || a.bidder != test.bidder {
+			t.Errorf("%v: won %v, closed %v, bidder %v - want %v, %v, %v", test.name, ack.Won, a.closed, a.bidder, test.won, test.closed, test.bidder)
+		}
+		if !test.closed {
+			continue
+		}
+		now := epoch.Add(100 * time.Millisecond)
+		if outcome := a.Outcome(now); outcome.Left != 0 || outcome.Amount != 500 || outcome.Price != 500 || outcome.Bidder != test.bidder {
+			t.Errorf("%v: Outcome() = %v, want it over & won by %v for 500", test.name, outcome, test.bidder)
+		}
+		if ack := s.Apply(at(10, 100, &DAS.Entry{Bid: &DAS.Amount{Id: 3, Bid: 2000, Auction: "a"}})); ack.Reason != DAS.Reason_AUCTION_CLOSED {
+			t.Errorf("%v: Apply() of a bid after it was won = %v, want %v", test.name, ack, DAS.Reason_AUCTION_CLOSED)
+		}
+	}
+}
+
+func TestCheckBuyNow(t *testing.T) {
+	tests := []struct {
+		name string
+		item *DAS.Item
+		ok   bool
+	}{
+		{"no price", &DAS.Item{Start: 10}, true},
+		{"above the starting bid", &DAS.Item{Start: 10, BuyNow: 11}, true},
+		{"at the starting bid", &DAS.Item{Start: 10, BuyNow: 10}, false},
+		{"at the reserve", &DAS.Item{Start: 10, Reserve: 50, BuyNow: 50}, false},
+	}
+	for _, test := range tests {
+		if message := CheckBuyNow(test.item); (message == "") != test.ok {
+			t.Errorf("%v: CheckBuyNow() = '%v'", test.name, message)
+		}
+	}
+}
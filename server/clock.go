@@ -0,0 +1,42 @@
+package main
+
+import (
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+)
+
+// every replica & client keeps a lamport clock. a replica witnesses the clock of every message it receives, & the leader
+// gives every entry it appends the next tick of its clock - so the order of the log is also the order of the clocks.
+// the state machine decides whether a bid is late by comparing its clock to the clock of the auctions close entry,
+// instead of the replicas (or even the leaders) wall clock
+
+// moves the clock past one we have seen, & returns the new time - has to be called while holding the mutex
+func (r *Replica) Witness(clock uint64) uint64 {
+	if clock > r.clock {
+		r.clock = clock
+	}
+	r.clock++
+	return r.clock
+}
+
+// the clock of the entry the message came with, 0 if it did not come with one - messages from older clients have none
+func MessageClock(entry *DAS.Entry) uint64 {
+	if amount := Bidding(entry); amount != nil {
+		return amount.Clock
+	} else if entry.Item != nil {
+		return entry.Item.Clock
+	}
+	return 0
+}
+
+// moves the clock past every entry in the log & the state, so a replica that becomes leader
+// never gives an entry a clock lower than one already in the log - has to be called while holding the mutex
+func (r *Replica) WitnessLog() {
+	if r.state.clock > r.clock {
+		r.clock = r.state.clock
+	}
+	for _, entry := range r.log {
+		if entry.Clock > r.clock {
+			r.clock = entry.Clock
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/LocatedInSpace/Distributed-Auction-System/config"
+)
+
+// Config is every setting of a replica, the consts in server.go are the defaults - see the config package for how they are given
+type Config struct {
+	Listen         string   `yaml:"listen" usage:"address to listen on, the other replicas have to know this one by it - empty is the first free address in peers"`
+	Peers          []string `yaml:"peers" usage:"comma separated address of every replica in the cluster, including this one - empty is replicas ports from base_port on localhost"`
+	BasePort       int      `yaml:"base_port" usage:"port of the first replica, when peers is empty"`
+	Replicas       int      `yaml:"replicas" usage:"amount of replicas in the cluster, when peers is empty - with file discovery, how many have to register before any of them leads"`
+	PreciseLogging bool     `yaml:"precise_logging" usage:"ups precision on timestamps"`
+	Discovery      string   `yaml:"discovery" usage:"how replicas find each other, static is peers - file is every replica registered in members_file"`
+	MembersFile    string   `yaml:"members_file" usage:"membership file replicas register in, when discovery is file"`
+}
+
+func LoadConfig() Config {
+	cfg := Config{
+		BasePort:       BASEPORT,
+		Replicas:       REPLICAS,
+		PreciseLogging: PRECISE_LOGGING,
+		Discovery:      DISCOVERY,
+		MembersFile:    MEMBERS_FILE,
+	}
+	if _, err := config.Load("server", &cfg, os.Args[1:]); err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	if cfg.Discovery != "static" && cfg.Discovery != "file" {
+		log.Fatalf("Unknown discovery '%v', it has to be static or file", cfg.Discovery)
+	}
+	// with file discovery, the peers are whoever registers - & any peers given are only connected to as well
+	if len(cfg.Peers) == 0 && cfg.Discovery == "static" {
+		for i := 0; i < cfg.Replicas; i++ {
+			cfg.Peers = append(cfg.Peers, fmt.Sprintf("localhost:%v", cfg.BasePort+i))
+		}
+	}
+	return cfg
+}
+
+// listens on the address in the config, or if it has none - the first address in peers that is free.
+// so replicas on the same machine can be started without telling each of them which one it is.
+// without peers either, it is the first free port on localhost from base_port
+func Listen(cfg Config) (net.Listener, string, uint16) {
+	addrs := cfg.Peers
+	if cfg.Listen != "" {
+		addrs = []string{cfg.Listen}
+	} else if len(addrs) == 0 {
+		for port := cfg.BasePort; port <= 65535; port++ {
+			addrs = append(addrs, fmt.Sprintf("localhost:%v", port))
+		}
+	}
+	for _, addr := range addrs {
+		_, portString, err := net.SplitHostPort(addr)
+		if err != nil {
+			log.Fatalf("Invalid replica address '%v': %v", addr, err)
+		}
+		port, err := strconv.ParseUint(portString, 10, 16)
+		if err != nil {
+			log.Fatalf("Invalid port in replica address '%v': %v", addr, err)
+		}
+		list, err := net.Listen("tcp", addr)
+		if err != nil {
+			log.Printf("Could not open listener on %v, trying the next peer\n", addr)
+			continue
+		}
+		return list, addr, uint16(port)
+	}
+	log.Fatalf("Could not listen on any of %v - is every replica already running?", addrs)
+	return nil, "", 0
+}
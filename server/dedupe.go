@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// a client that times out waiting on a bid cannot tell whether it was applied, so it retries it with the same request key.
+// the state keeps the ack of every keyed bid for DEDUPE_TTL, & a bid with a key it has seen gets that ack back instead of
+// being applied again. the table is part of the state, so every replica has it - & a new leader answers retries the same way.
+// entries expire by the time the leader appended them with, never the local clock
+
+// the ack a keyed bid was answered with
+type request struct {
+	ack *DAS.Ack
+	at  time.Time // when the bid was appended
+}
+
+// how a request is encoded in snapshots
+type requestRecord struct {
+	Key string `json:"key"`
+	Ack []byte `json:"ack"`
+	At  int64  `json:"at"` // unix milliseconds
+}
+
+// the key a bid is deduplicated by, empty if it is not - keys are per bidder, so clients cannot collide
+func RequestKey(amount *DAS.Amount) string {
+	if amount.Request == "" {
+		return ""
+	}
+	return fmt.Sprintf("%v/%v", amount.Id, amount.Request)
+}
+
+// returns the ack a bid was already answered with, nil if it has not been applied - or it was longer than DEDUPE_TTL
+// before now, even if it was not dropped yet
+func (s *State) Seen(amount *DAS.Amount, now time.Time) *DAS.Ack {
+	r, ok := s.requests[RequestKey(amount)]
+	if !ok || now.Sub(r.at) >= DEDUPE_TTL*time.Millisecond {
+		return nil
+	}
+	return proto.Clone(r.ack).(*DAS.Ack)
+}
+
+// keeps the ack a keyed bid was answered with, & drops the ones older than DEDUPE_TTL
+func (s *State) remember(amount *DAS.Amount, ack *DAS.Ack, now time.Time) {
+	for len(s.order) > 0 {
+		oldest := s.requests[s.order[0]]
+		if oldest != nil && now.Sub(oldest.at) < DEDUPE_TTL*time.Millisecond {
+			break
+		}
+		delete(s.requests, s.order[0])
+		s.order = s.order[1:]
+	}
+	key := RequestKey(amount)
+	if key == "" {
+		return
+	}
+	s.requests[key] = &request{ack: proto.Clone(ack).(*DAS.Ack), at: now}
+	s.order = append(s.order, key)
+}
+
+func (s *State) encodeRequests() []requestRecord {
+	var records []requestRecord
+	for _, key := range s.order {
+		r := s.requests[key]
+		data, err := proto.Marshal(r.ack)
+		if err != nil {
+			log.Fatalf("Failed to encode ack of request '%v': %v", key, err)
+		}
+		records = append(records, requestRecord{Key: key, Ack: data, At: r.at.UnixMilli()})
+	}
+	return records
+}
+
+func (s *State) decodeRequests(records []requestRecord) error {
+	for _, record := range records {
+		ack := &DAS.Ack{}
+		if err := proto.Unmarshal(record.Ack, ack); err != nil {
+			return fmt.Errorf("request '%v': %v", record.Key, err)
+		}
+		s.requests[record.Key] = &request{ack: ack, at: time.UnixMilli(record.At)}
+		s.order = append(s.order, record.Key)
+	}
+	return nil
+}
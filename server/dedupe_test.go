@@ -0,0 +1,34 @@
+package main
+
+import (
+	"testing"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+)
+
+func TestDedupe(t *testing.T) {
+	s := NewState()
+	s.Apply(at(1, 0, &DAS.Entry{Auction: "a", Item: &DAS.Item{Name: "a", Start: 10, Alive: 1000}}))
+	first := s.Apply(at(2, 10, &DAS.Entry{Bid: &DAS.Amount{Id: 1, Bid: 20, Auction: "a", Request: "one"}}))
+	if first.Response != DAS.Acks_SUCCESS {
+		t.Fatalf("Apply() of the bid = %v", first)
+	}
+	// the retry is appended after another bid, so applying it again would be rejected
+	s.Apply(at(3, 20, &DAS.Entry{Bid: &DAS.Amount{Id: 2, Bid: 30, Auction: "a"}}))
+	retry := s.Apply(at(4, 30, &DAS.Entry{Bid: &DAS.Amount{Id: 1, Bid: 20, Auction: "a", Request: "one"}}))
+	if retry.String() != first.String() {
+		t.Errorf("Apply() of the retry = %v, want %v", retry, first)
+	}
+	if bids := len(s.Get("a").bids); bids != 2 {
+		t.Errorf("ledger has %v bids, want 2", bids)
+	}
+	// the same key from another bidder is another bid
+	if ack := s.Apply(at(5, 40, &DAS.Entry{Bid: &DAS.Amount{Id: 3, Bid: 40, Auction: "a", Request: "one"}})); ack.Clock != 5 {
+		t.Errorf("Apply() of another bidders bid = %v, want it applied at clock 5", ack)
+	}
+	// & the ack is forgotten once DEDUPE_TTL is up
+	late := s.Apply(at(6, 10+DEDUPE_TTL, &DAS.Entry{Bid: &DAS.Amount{Id: 1, Bid: 20, Auction: "a", Request: "one"}}))
+	if late.Clock != 6 {
+		t.Errorf("Apply() after DEDUPE_TTL = %v, want it applied at clock 6", late)
+	}
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"log"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+)
+
+// every replica sends a heartbeat to each of its peers every DETECT_EVERY, & keeps how long apart the replies arrive.
+// how suspicious it is of a peer (phi) is how unlikely it is, that the reply is only late - given the intervals seen so far.
+// this is the phi accrual failure detector, so a peer on a slow link is not given up on as fast as one that is normally quick.
+// the leader steps down & stops taking writes once it believes it has lost a majority, & a follower that believes the
+// leader is dead starts an election without waiting out the rest of its election timeout
+
+// keeps when each peer answered - phi of a peer answering in time is ~0, 8 is a 1 in 10^8 chance of the reply only being late
+type Detector struct {
+	mutex   sync.Mutex
+	peers   map[string]*arrivals
+	pending map[string]bool // peers we are waiting on a heartbeat reply from, so a hung peer does not pile up calls
+}
+
+type arrivals struct {
+	last      time.Time
+	intervals []time.Duration // the last DETECT_WINDOW intervals between replies
+}
+
+func NewDetector() *Detector {
+	return &Detector{
+		peers:   make(map[string]*arrivals),
+		pending: make(map[string]bool),
+	}
+}
+
+// records a reply from a peer
+func (d *Detector) Heard(addr string, now time.Time) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	a, ok := d.peers[addr]
+	if !ok {
+		d.peers[addr] = &arrivals{last: now}
+		return
+	}
+	a.intervals = append(a.intervals, now.Sub(a.last))
+	if len(a.intervals) > DETECT_WINDOW {
+		a.intervals = a.intervals[1:]
+	}
+	a.last = now
+}
+
+// returns how suspicious we are of a peer, +Inf if it has never answered
+func (d *Detector) Phi(addr string, now time.Time) float64 {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	a, ok := d.peers[addr]
+	if !ok {
+		return math.Inf(1)
+	}
+	// until there are intervals to go by, the replies are expected every DETECT_EVERY
+	mean := float64(DETECT_EVERY)
+	if len(a.intervals) > 0 {
+		mean = 0
+		for _, interval := range a.intervals {
+			mean += float64(interval.Milliseconds())
+		}
+		mean /= float64(len(a.intervals))
+	}
+	variance := 0.0
+	for _, interval := range a.intervals {
+		diff := float64(interval.Milliseconds()) - mean
+		variance += diff * diff
+	}
+	deviation := MIN_DEVIATION
+	if len(a.intervals) > 0 {
+		deviation = math.Max(math.Sqrt(variance/float64(len(a.intervals))), MIN_DEVIATION)
+	}
+	// the chance of a reply taking longer than it has, if the intervals are normally distributed - approximated by a logistic curve
+	elapsed := float64(now.Sub(a.last).Milliseconds())
+	y := (elapsed - mean) / deviation
+	e := math.Exp(-y * (1.5976 + 0.070566*y*y))
+	if elapsed > mean {
+		return -math.Log10(e / (1 + e))
+	}
+	return -math.Log10(1 - 1/(1+e))
+}
+
+// returns whether we believe a peer is alive
+func (d *Detector) Alive(addr string, now time.Time) bool {
+	return d.Phi(addr, now) < PHI_THRESHOLD
+}
+
+// milliseconds since a peer last answered, -1 if it never has
+func (d *Detector) LastSeen(addr string, now time.Time) int64 {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if a, ok := d.peers[addr]; ok {
+		return now.Sub(a.last).Milliseconds()
+	}
+	return -1
+}
+
+// forgets a peer that left the cluster, so it is a stranger again if it rejoins
+func (d *Detector) Forget(addr string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	delete(d.peers, addr)
+}
+
+// runs for as long as the replica does, sending a heartbeat to every peer every DETECT_EVERY
+func (r *Replica) Detect() {
+	for {
+		time.Sleep(DETECT_EVERY * time.Millisecond)
+		r.mutex.Lock()
+		peers := make(map[string]DAS.DASClient)
+		for addr, peer := range r.peers {
+			peers[addr] = peer
+		}
+		r.mutex.Unlock()
+
+		for addr, peer := range peers {
+			r.detector.mutex.Lock()
+			pending := r.detector.pending[addr]
+			r.detector.pending[addr] = true
+			r.detector.mutex.Unlock()
+			if pending {
+				continue
+			}
+			go func(addr string, peer DAS.DASClient) {
+				ctx, cancel := context.WithTimeout(context.Background(), RPC_TIMEOUT*time.Millisecond)
+				defer cancel()
+				_, err := peer.Heartbeat(ctx, &DAS.Beat{From: r.addr})
+				if err == nil {
+					r.detector.Heard(addr, time.Now())
+				}
+				r.detector.mutex.Lock()
+				delete(r.detector.pending, addr)
+				r.detector.mutex.Unlock()
+			}(addr, peer)
+		}
+		r.CheckQuorum()
+	}
+}
+
+// steps down as leader if we believe we have lost a majority, so clients are not left waiting on writes that cannot commit
+func (r *Replica) CheckQuorum() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if !r.LostMajority() {
+		return
+	}
+	log.Printf("CheckQuorum() | Stepped down as leader, only %v of %v replicas are alive\n", r.AliveMembers(), r.ClusterSize())
+	r.role = FOLLOWER
+	r.leader = ""
+}
+
+// Heartbeat is sent by the failure detectors of our peers
+func (r *Replica) Heartbeat(ctx context.Context, beat *DAS.Beat) (*DAS.Beat, error) {
+	return &DAS.Beat{From: r.addr}, nil
+}
+
+// Health returns which of our peers we believe are alive
+func (r *Replica) Health(ctx context.Context, _ *DAS.Empty) (*DAS.HealthReport, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	now := time.Now()
+	report := &DAS.HealthReport{
+		Addr:     r.addr,
+		Leader:   r.leader,
+		Term:     r.term,
+		Majority: r.HasMajority(),
+	}
+	var addrs []string
+	for addr := range r.peers {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	for _, addr := range addrs {
+		phi := r.detector.Phi(addr, now)
+		report.Peers = append(report.Peers, &DAS.PeerHealth{
+			Addr:     addr,
+			Alive:    phi < PHI_THRESHOLD,
+			Phi:      phi,
+			LastSeen: r.detector.LastSeen(addr, now),
+		})
+	}
+	return report, nil
+}
+
+// the amount of members we believe are alive, including us - has to be called while holding the mutex
+func (r *Replica) AliveMembers() int {
+	now := time.Now()
+	alive := 0
+	if r.IsMember(r.addr) {
+		alive++
+	}
+	for addr := range r.peers {
+		if r.IsMember(addr) && r.detector.Alive(addr, now) {
+			alive++
+		}
+	}
+	return alive
+}
+
+// returns whether we believe a majority of the cluster is alive - has to be called while holding the mutex
+func (r *Replica) HasMajority() bool {
+	return r.AliveMembers() > r.ClusterSize()/2
+}
+
+// returns whether we are leader, & believe we have lost the majority that elected us. a new leader is given
+// ELECTION_TIMEOUT first, since peers that started together with it might not have answered a heartbeat yet.
+// has to be called while holding the mutex
+func (r *Replica) LostMajority() bool {
+	return r.role == LEADER && time.Since(r.leading) > ELECTION_TIMEOUT*time.Millisecond && !r.HasMajority()
+}
+
+// returns whether we are a follower, that believes the leader is dead - has to be called while holding the mutex
+func (r *Replica) LeaderSuspected() bool {
+	return r.role == FOLLOWER && r.leader != "" && !r.detector.Alive(r.leader, time.Now())
+}
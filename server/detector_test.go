@@ -0,0 +1,43 @@
+package main
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestPhi(t *testing.T) {
+	d := NewDetector()
+	now := time.UnixMilli(0)
+	if phi := d.Phi("peer", now); !math.IsInf(phi, 1) {
+		t.Errorf("Phi() of a peer that never answered = %v, want +Inf", phi)
+	}
+	// a steady peer, answering every DETECT_EVERY
+	for i := 0; i < 20; i++ {
+		now = now.Add(DETECT_EVERY * time.Millisecond)
+		d.Heard("peer", now)
+	}
+	tests := []struct {
+		name  string
+		after time.Duration
+		alive bool
+	}{
+		{"right after a reply", 10 * time.Millisecond, true},
+		{"when the next reply is due", DETECT_EVERY * time.Millisecond, true},
+		{"long after the next reply was due", 2 * time.Second, false},
+	}
+	for _, test := range tests {
+		if alive := d.Alive("peer", now.Add(test.after)); alive != test.alive {
+			t.Errorf("%v: Alive() = %v with phi %v, want %v", test.name, alive, d.Phi("peer", now.Add(test.after)), test.alive)
+		}
+	}
+	// suspicion only grows the longer the peer is silent
+	last := 0.0
+	for after := time.Duration(0); after <= time.Second; after += 100 * time.Millisecond {
+		phi := d.Phi("peer", now.Add(after))
+		if phi < last {
+			t.Errorf("Phi() dropped from %v to %v at %v", last, phi, after)
+		}
+		last = phi
+	}
+}
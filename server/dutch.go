@@ -0,0 +1,76 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+)
+
+// a dutch auction starts at the starting bid, & the price drops by drop every dropEvery milliseconds - down to the reserve.
+// the first bid at or above the price wins right away, & pays the price. the price is worked out from when the auction
+// was started & when the bid was appended, both times the leader gave the entries - so every replica gets the same price
+
+// returns the price of a dutch auction at the given time
+func (a *Auction) ClockPrice(now time.Time) uint64 {
+	// a leader or follower with a clock behind the one that started the auction, sees it before it started
+	if a.dropEvery == 0 || a.drop == 0 || now.Before(a.auctionStart) {
+		return a.highestBid
+	}
+	steps := uint64(now.Sub(a.auctionStart).Milliseconds()) / uint64(a.dropEvery)
+	// the price never drops below the reserve, or 1 - so it is never given away
+	floor := a.reserve
+	if floor == 0 {
+		floor = 1
+	}
+	if a.highestBid <= floor {
+		return floor
+	}
+	// steps is compared to how many whole drops are left above the floor before multiplying, so a huge drop or a
+	// long time cannot wrap around to a price above the floor
+	if steps > (a.highestBid-floor)/a.drop {
+		return floor
+	}
+	return a.highestBid - steps*a.drop
+}
+
+// returns why a dutch auction cannot be started with the item, empty if it can
+func CheckDutch(item *DAS.Item) string {
+	switch {
+	case item.Format != DAS.Format_DUTCH:
+		return ""
+	case item.Drop == 0 || item.DropEvery == 0:
+		return "The price of a dutch auction has to drop by more than 0, every more than 0 milliseconds"
+	case item.Reserve > item.Start:
+		return "The reserve of a dutch auction is the lowest price, it cannot be above the starting bid"
+	}
+	return ""
+}
+
+// takes a bid on a dutch auction, which wins it if it is at least the price
+func (s *State) applyDutch(auction *Auction, amount *DAS.Amount, now time.Time, clock uint64) *DAS.Ack {
+	price := auction.ClockPrice(now)
+	if amount.Bid < price {
+		log.Printf("Apply() | Rejected bid from %v on '%v' at clock %v (sent at %v), amount: %v - price is %v\n", amount.Id, auction.id, clock, amount.Clock, amount.Bid, price)
+		return &DAS.Ack{
+			Response: DAS.Acks_FAIL,
+			Message:  "Bid is lower than the price",
+			Auction:  auction.id,
+			Reason:   DAS.Reason_BID_TOO_LOW,
+			Minimum:  price,
+		}
+	}
+	log.Printf("Apply() | Accepted bid from %v on '%v' at clock %v (sent at %v), amount: %v - paying the price of %v\n", amount.Id, auction.id, clock, amount.Clock, amount.Bid, price)
+	auction.bidder = amount.Id
+	auction.highestBid = price
+	auction.clock = clock
+	s.end(auction, now, clock)
+	return &DAS.Ack{
+		Response: DAS.Acks_SUCCESS,
+		Message:  "Bid won the auction",
+		Auction:  auction.id,
+		Highest:  price,
+		Bidder:   amount.Id,
+		Won:      true,
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+)
+
+func TestClockPrice(t *testing.T) {
+	start := time.UnixMilli(1_000_000)
+	tests := []struct {
+		name    string
+		drop    uint64
+		every   uint32
+		reserve uint64
+		after   time.Duration
+		price   uint64
+	}{
+		{"at the start", 10, 1000, 0, 0, 100},
+		{"before a full step", 10, 1000, 0, 999 * time.Millisecond, 100},
+		{"after steps", 10, 1000, 0, 2500 * time.Millisecond, 80},
+		{"clock behind the start", 10, 1000, 0, -5 * time.Second, 100},
+		{"down to 1 without a reserve", 10, 1000, 0, time.Hour, 1},
+		{"down to the reserve", 10, 1000, 35, 7 * time.Second, 35},
+		{"exactly at the reserve", 10, 1000, 30, 7 * time.Second, 30},
+		{"no drop", 0, 1000, 0, time.Hour, 100},
+		{"no schedule", 10, 0, 0, time.Hour, 100},
+		{"the largest drop at the start", math.MaxUint64, 1000, 0, 0, 100},
+		{"the largest drop after a step", math.MaxUint64, 1000, 0, time.Second, 1},
+		{"a drop that does not fit in what is left", 60, 1000, 0, time.Second, 40},
+		{"a drop that does not fit twice", 60, 1000, 0, 2 * time.Second, 1},
+	}
+	for _, test := range tests {
+		a := &Auction{format: DAS.Format_DUTCH, highestBid: 100, auctionStart: start, drop: test.drop, dropEvery: test.every, reserve: test.reserve}
+		if price := a.ClockPrice(start.Add(test.after)); price != test.price {
+			t.Errorf("%v: ClockPrice() = %v, want %v", test.name, price, test.price)
+		}
+	}
+}
+
+func TestCheckDutch(t *testing.T) {
+	tests := []struct {
+		name string
+		item *DAS.Item
+		ok   bool
+	}{
+		{"valid", &DAS.Item{Format: DAS.Format_DUTCH, Start: 100, Drop: 10, DropEvery: 1000, Reserve: 50}, true},
+		{"no drop", &DAS.Item{Format: DAS.Format_DUTCH, Start: 100, DropEvery: 1000}, false},
+		{"no schedule", &DAS.Item{Format: DAS.Format_DUTCH, Start: 100, Drop: 10}, false},
+		{"reserve above the start", &DAS.Item{Format: DAS.Format_DUTCH, Start: 100, Drop: 10, DropEvery: 1000, Reserve: 101}, false},
+		{"not dutch", &DAS.Item{Format: DAS.Format_OPEN, Start: 100}, true},
+	}
+	for _, test := range tests {
+		if message := CheckDutch(test.item); (message == "") != test.ok {
+			t.Errorf("%v: CheckDutch() = '%v', want ok %v", test.name, message, test.ok)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// requests that are turned down are answered with a grpc status error, with a Failure saying why in its details -
+// but only to clients that send STATUS_ERRORS_HEADER. older clients read the ack, so they are still sent that instead.
+// redirects are not errors either way, they are how followers vote for the leader
+
+const STATUS_ERRORS_HEADER = "das-errors" // metadata a client sends, with the value "status", to be answered with status errors
+
+// the status code each reason is sent with
+var reasonCodes = map[DAS.Reason]codes.Code{
+	DAS.Reason_AUCTION_CLOSED:      codes.FailedPrecondition,
+	DAS.Reason_BID_TOO_LOW:         codes.FailedPrecondition,
+	DAS.Reason_NO_AUCTION:          codes.NotFound,
+	DAS.Reason_NOT_UNIQUE:          codes.AlreadyExists,
+	DAS.Reason_NO_MAJORITY:         codes.Unavailable,
+	DAS.Reason_TIMED_OUT:           codes.Unavailable,
+	DAS.Reason_LEADER_CHANGED:      codes.Unavailable,
+	DAS.Reason_MEMBERSHIP_CHANGING: codes.Aborted,
+	DAS.Reason_LAST_MEMBER:         codes.FailedPrecondition,
+	DAS.Reason_BAD_PAGE_TOKEN:      codes.InvalidArgument,
+	DAS.Reason_ALREADY_BID:         codes.FailedPrecondition,
+	DAS.Reason_NOT_SUPPORTED:       codes.FailedPrecondition,
+	DAS.Reason_NOT_REVEALING:       codes.FailedPrecondition,
+	DAS.Reason_BAD_COMMITMENT:      codes.InvalidArgument,
+	DAS.Reason_BAD_AUCTION:         codes.InvalidArgument,
+}
+
+// returns whether the client asked to be answered with status errors
+func WantsStatus(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, value := range md.Get(STATUS_ERRORS_HEADER) {
+		if value == "status" {
+			return true
+		}
+	}
+	return false
+}
+
+// returns the ack to answer a write with - or if it turned the write down & the client wants status errors, the error instead
+func Reply(ctx context.Context, ack *DAS.Ack) (*DAS.Ack, error) {
+	if ack.Response == DAS.Acks_SUCCESS || ack.Response == DAS.Acks_REDIRECT || !WantsStatus(ctx) {
+		return ack, nil
+	}
+	return nil, Failed(ack.Message, &DAS.Failure{
+		Reason:  ack.Reason,
+		Auction: ack.Auction,
+		Highest: ack.Highest,
+		Bidder:  ack.Bidder,
+		Minimum: ack.Minimum,
+		Clock:   ack.Clock,
+		Epoch:   ack.Epoch,
+	})
+}
+
+// returns the status error for a failure
+func Failed(message string, failure *DAS.Failure) error {
+	code, ok := reasonCodes[failure.Reason]
+	if !ok {
+		code = codes.Unknown
+	}
+	st, err := status.New(code, message).WithDetails(failure)
+	if err != nil {
+		log.Printf("Failed() | Could not add details to status: %v\n", err)
+		return status.Error(code, message)
+	}
+	return st.Err()
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+)
+
+// every auction ever started is kept in the state, so auctions that are over can still be looked up - & their winners.
+// the page token of ListAuctions is the index of the next auction to look at, in the order they were started - so an auction
+// closing while the client pages through does not move the ones after it
+
+// returns whether the auction is one the query asks for, at the given time
+func (a *Auction) Matches(query *DAS.AuctionsQuery, now time.Time) bool {
+	started := a.auctionStart.UnixMilli()
+	if started < query.Since || (query.Until != 0 && started >= query.Until) {
+		return false
+	}
+	switch query.Filter {
+	case DAS.Filter_LIVE:
+		return !a.Over(now)
+	case DAS.Filter_CLOSED:
+		return a.Over(now)
+	case DAS.Filter_UNSOLD:
+		return a.Decided(now) && !a.Sold(now)
+	case DAS.Filter_SOLD:
+		return a.Sold(now)
+	}
+	return true
+}
+
+// ListAuctions is answered by the leader, with a page of the auctions the query asks for
+func (r *Replica) ListAuctions(ctx context.Context, query *DAS.AuctionsQuery) (*DAS.AuctionPage, error) {
+	if !r.ReadIndex() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		return &DAS.AuctionPage{Leader: r.leader, Redirect: true}, nil
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	start, ok := PageStart(query.PageToken, len(r.state.auctions))
+	if !ok {
+		return nil, Failed("Page token is not from this listing", &DAS.Failure{Reason: DAS.Reason_BAD_PAGE_TOKEN, Epoch: r.state.epoch})
+	}
+	now := time.Now()
+	size := PageSize(query.PageSize)
+	page := &DAS.AuctionPage{Epoch: r.state.epoch}
+	i := start
+	for ; i < len(r.state.auctions) && len(page.Auctions) < size; i++ {
+		if auction := r.state.auctions[i]; auction.Matches(query, now) {
+			page.Auctions = append(page.Auctions, auction.Outcome(now))
+		}
+	}
+	if i < len(r.state.auctions) {
+		page.NextPageToken = strconv.Itoa(i)
+	}
+	log.Printf("ListAuctions() | Sent %v %v auctions, looked at %v to %v of %v\n", len(page.Auctions), query.Filter, start, i, len(r.state.auctions))
+	return page, nil
+}
+
+// GetAuction is answered by the leader, with the auction with an id - whether it is over or not
+func (r *Replica) GetAuction(ctx context.Context, query *DAS.Query) (*DAS.Outcome, error) {
+	if !r.ReadIndex() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		return &DAS.Outcome{Leader: r.leader, Redirect: true}, nil
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	auction, ok := r.state.byID[query.Auction]
+	if !ok {
+		log.Printf("GetAuction() | Told client that there is no auction '%v'\n", query.Auction)
+		return nil, Failed("No such auction", &DAS.Failure{Reason: DAS.Reason_NO_AUCTION, Auction: query.Auction, Epoch: r.state.epoch})
+	}
+	outcome := auction.Outcome(time.Now())
+	outcome.Epoch = r.state.epoch
+	log.Printf("GetAuction() | Sent auction '%v' for '%s'\n", auction.id, auction.item)
+	return outcome, nil
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+)
+
+// every bid placed on an auction is kept in its ledger as it was applied, the ones turned down too - so disputes
+// can be settled from what every replica agrees happened. maximum bids go in it as well, without the amount - it is
+// kept from the other bidders. bids on auctions that do not exist have no ledger to go in
+
+// how a bid is encoded in snapshots
+type bidRecord struct {
+	Bidder     uint32     `json:"bidder"`
+	Amount     uint64     `json:"amount"`
+	Time       int64      `json:"time"`
+	Clock      uint64     `json:"clock"`
+	Accepted   bool       `json:"accepted"`
+	Reason     DAS.Reason `json:"reason"`
+	Request    string     `json:"request,omitempty"`
+	Proxy      bool       `json:"proxy,omitempty"`
+	Commitment []byte     `json:"commitment,omitempty"`
+	Reveal     bool       `json:"reveal,omitempty"`
+	Maximum    bool       `json:"maximum,omitempty"`
+}
+
+// adds a bid to the ledger of the auction it was placed on, with the ack the bidder was told - so it has to be called
+// once the bid has been answered, by the maximum bids too
+func (s *State) record(entry *DAS.Entry, ack *DAS.Ack) {
+	auction, ok := s.byID[ack.Auction]
+	if !ok {
+		return
+	}
+	amount := Bidding(entry)
+	bid := &DAS.BidRecord{
+		Bidder:     amount.Id,
+		Amount:     amount.Bid,
+		Time:       entry.Time,
+		Clock:      entry.Clock,
+		Accepted:   ack.Response == DAS.Acks_SUCCESS,
+		Reason:     ack.Reason,
+		Request:    amount.Request,
+		Commitment: entry.Commit.GetCommitment(),
+		Reveal:     entry.Reveal != nil,
+		Maximum:    entry.MaxBid != nil,
+	}
+	// a commitment is not a bid, whatever amount was sent with it - & a maximum bid is kept from the other bidders
+	if entry.Commit != nil || entry.MaxBid != nil {
+		bid.Amount = 0
+	}
+	// the bids placed up to maximum bids in answer to it were placed by the same entry, & go after it
+	at := len(auction.bids)
+	for at > 0 && auction.bids[at-1].Proxy && auction.bids[at-1].Clock == entry.Clock {
+		at--
+	}
+	auction.bids = append(auction.bids[:at], append([]*DAS.BidRecord{bid}, auction.bids[at:]...)...)
+}
+
+func encodeBids(bids []*DAS.BidRecord) []bidRecord {
+	records := make([]bidRecord, len(bids))
+	for i, bid := range bids {
+		records[i] = bidRecord{
+			Bidder:     bid.Bidder,
+			Amount:     bid.Amount,
+			Time:       bid.Time,
+			Clock:      bid.Clock,
+			Accepted:   bid.Accepted,
+			Reason:     bid.Reason,
+			Request:    bid.Request,
+			Proxy:      bid.Proxy,
+			Commitment: bid.Commitment,
+			Reveal:     bid.Reveal,
+			Maximum:    bid.Maximum,
+		}
+	}
+	return records
+}
+
+func decodeBids(records []bidRecord) []*DAS.BidRecord {
+	bids := make([]*DAS.BidRecord, len(records))
+	for i, record := range records {
+		bids[i] = &DAS.BidRecord{
+			Bidder:     record.Bidder,
+			Amount:     record.Amount,
+			Time:       record.Time,
+			Clock:      record.Clock,
+			Accepted:   record.Accepted,
+			Reason:     record.Reason,
+			Request:    record.Request,
+			Proxy:      record.Proxy,
+			Commitment: record.Commitment,
+			Reveal:     record.Reveal,
+			Maximum:    record.Maximum,
+		}
+	}
+	return bids
+}
+
+// ListBids is answered by the leader, with a page of the ledger of an auction. the page token is the index of the
+// first bid on the page - the ledger is only ever appended to, so a token stays good for as long as the auction exists
+func (r *Replica) ListBids(ctx context.Context, query *DAS.BidsQuery) (*DAS.BidPage, error) {
+	if !r.ReadIndex() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		return &DAS.BidPage{Leader: r.leader, Redirect: true}, nil
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	auction := r.state.Get(query.Auction)
+	if auction == nil {
+		log.Printf("ListBids() | Told client that there is no auction '%v'\n", query.Auction)
+		return nil, Failed("No such auction", &DAS.Failure{Reason: DAS.Reason_NO_AUCTION, Auction: query.Auction, Epoch: r.state.epoch})
+	}
+	start, ok := PageStart(query.PageToken, len(auction.bids))
+	if !ok {
+		return nil, Failed("Page token is not from this auction", &DAS.Failure{Reason: DAS.Reason_BAD_PAGE_TOKEN, Auction: auction.id, Epoch: r.state.epoch})
+	}
+	end := start + PageSize(query.PageSize)
+	if end > len(auction.bids) {
+		end = len(auction.bids)
+	}
+	page := &DAS.BidPage{
+		Auction: auction.id,
+		Bids:    auction.Ledger(start, end, time.Now()),
+		Total:   uint32(len(auction.bids)),
+		Epoch:   r.state.epoch,
+	}
+	if end < len(auction.bids) {
+		page.NextPageToken = strconv.Itoa(end)
+	}
+	log.Printf("ListBids() | Sent bids %v to %v of %v on '%v'\n", start, end, len(auction.bids), auction.id)
+	return page, nil
+}
+
+// returns the amount of items on a page, when the client asked for size
+func PageSize(size uint32) int {
+	if size == 0 {
+		return DEFAULT_PAGE_SIZE
+	} else if size > MAX_PAGE_SIZE {
+		return MAX_PAGE_SIZE
+	}
+	return int(size)
+}
+
+// returns the index a page token points at, in a list of length items - false if it is not a token for the list
+func PageStart(token string, length int) (int, bool) {
+	if token == "" {
+		return 0, true
+	}
+	start, err := strconv.Atoi(token)
+	if err != nil || start < 0 || start > length {
+		return 0, false
+	}
+	return start, true
+}
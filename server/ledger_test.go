@@ -0,0 +1,71 @@
+package main
+
+import (
+	"testing"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+)
+
+func TestPageStart(t *testing.T) {
+	tests := []struct {
+		token  string
+		length int
+		start  int
+		ok     bool
+	}{
+		{"", 0, 0, true},
+		{"5", 10, 5, true},
+		{"10", 10, 10, true},
+		{"11", 10, 0, false},
+		{"-1", 10, 0, false},
+		{"abc", 10, 0, false},
+	}
+	for _, test := range tests {
+		start, ok := PageStart(test.token, test.length)
+		if start != test.start || ok != test.ok {
+			t.Errorf("PageStart('%v', %v) = %v, %v - want %v, %v", test.token, test.length, start, ok, test.start, test.ok)
+		}
+	}
+}
+
+func TestPageSize(t *testing.T) {
+	tests := []struct {
+		size uint32
+		want int
+	}{
+		{0, DEFAULT_PAGE_SIZE},
+		{5, 5},
+		{MAX_PAGE_SIZE + 1, MAX_PAGE_SIZE},
+	}
+	for _, test := range tests {
+		if size := PageSize(test.size); size != test.want {
+			t.Errorf("PageSize(%v) = %v, want %v", test.size, size, test.want)
+		}
+	}
+}
+
+func TestRecord(t *testing.T) {
+	s := NewState()
+	s.Apply(at(1, 0, &DAS.Entry{Auction: "a", Item: &DAS.Item{Name: "a", Start: 10, Alive: 1000, Increment: 5}}))
+	s.Apply(at(2, 10, &DAS.Entry{MaxBid: &DAS.Amount{Id: 1, Bid: 100, Auction: "a"}}))
+	s.Apply(at(3, 20, &DAS.Entry{MaxBid: &DAS.Amount{Id: 2, Bid: 5, Auction: "a"}}))
+	s.Apply(at(4, 30, &DAS.Entry{Bid: &DAS.Amount{Id: 2, Bid: 50, Auction: "a"}}))
+	want := []*DAS.BidRecord{
+		{Bidder: 1, Clock: 2, Accepted: true, Maximum: true},
+		{Bidder: 1, Amount: 15, Clock: 2, Accepted: true, Proxy: true},
+		{Bidder: 2, Clock: 3, Reason: DAS.Reason_BID_TOO_LOW, Maximum: true},
+		// outbid by the maximum right away, so it is turned down - & goes before the bid placed in answer to it
+		{Bidder: 2, Amount: 50, Clock: 4, Reason: DAS.Reason_BID_TOO_LOW},
+		{Bidder: 1, Amount: 55, Clock: 4, Accepted: true, Proxy: true},
+	}
+	bids := s.Get("a").bids
+	if len(bids) != len(want) {
+		t.Fatalf("ledger has %v bids, want %v: %v", len(bids), len(want), bids)
+	}
+	for i, bid := range bids {
+		bid.Time = 0
+		if bid.String() != want[i].String() {
+			t.Errorf("bid %v is %v, want %v", i, bid, want[i])
+		}
+	}
+}
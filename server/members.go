@@ -0,0 +1,308 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/LocatedInSpace/Distributed-Auction-System/discovery"
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+)
+
+// with discovery set to file, replicas find each other through a membership file instead of a fixed list of peers.
+// a replica registers itself once it is listening, & keeps renewing its registration for as long as it runs -
+// every time it does, it connects to the replicas that have registered since. once anyone has joined or left
+// the cluster, the membership in the log decides who the peers are instead - & registering asks the leader to join.
+// the cluster starts out as the first bootstrap replicas to register, & noone leads until all of them have - so a
+// majority is never counted out of a cluster that is still growing. replicas registering after that join through the leader
+
+// returns the resolver for the discovery in the config
+func Resolver(cfg Config) discovery.Resolver {
+	if cfg.Discovery == "file" {
+		return discovery.File{Path: cfg.MembersFile}
+	}
+	return discovery.Static(cfg.Peers)
+}
+
+// registers the replica in the membership file, & connects to every replica already in it
+func (r *Replica) Join(members discovery.File) {
+	if err := members.Register(r.addr); err != nil {
+		log.Fatalf("Failed to register in '%v': %v", members.Path, err)
+	}
+	addrs, err := members.Resolve()
+	if err != nil {
+		log.Fatalf("Failed to read '%v': %v", members.Path, err)
+	}
+	log.Printf("Join() | Registered in '%v', %v replicas are registered\n", members.Path, len(addrs))
+	r.mutex.Lock()
+	others := len(addrs)
+	if Contains(addrs, r.addr) {
+		others--
+	}
+	if r.epoch == 0 && others >= r.bootstrap {
+		r.joining = true
+		log.Printf("Join() | %v replicas were registered already, we have to join the cluster through the leader\n", others)
+	}
+	r.mutex.Unlock()
+	r.ConnectPeers(addrs)
+
+	// a replica that is stopped leaves & deregisters, so noone waits for its lease to run out
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-stop
+		r.mutex.Lock()
+		leave := r.epoch > 0 && r.IsMember(r.addr)
+		r.mutex.Unlock()
+		if leave && r.RequestMembership(false) {
+			log.Printf("Join() | Left the cluster\n")
+		}
+		members.Deregister(r.addr)
+		log.Printf("Join() | Deregistered from '%v'\n", members.Path)
+		os.Exit(0)
+	}()
+}
+
+// runs for as long as the replica does, renewing its registration & connecting to replicas that registered since
+func (r *Replica) KeepRegistered(members discovery.File) {
+	for {
+		time.Sleep(discovery.REGISTER_EVERY * time.Millisecond)
+		if err := members.Register(r.addr); err != nil {
+			log.Printf("KeepRegistered() | Failed to renew registration: %v\n", err)
+			continue
+		}
+		addrs, err := members.Resolve()
+		if err != nil {
+			log.Printf("KeepRegistered() | Failed to read members: %v\n", err)
+			continue
+		}
+		r.mutex.Lock()
+		if r.epoch > 0 || r.joining {
+			// the peers are connected to, so we know who to ask - they do not count towards any majority of ours
+			if r.epoch == 0 {
+				for _, addr := range addrs {
+					r.AddPeer(addr)
+				}
+			}
+			join := !r.IsMember(r.addr)
+			r.mutex.Unlock()
+			if join && r.RequestMembership(true) {
+				log.Printf("KeepRegistered() | Joined the cluster\n")
+			}
+			continue
+		}
+		for _, addr := range addrs {
+			// once the cluster is as big as it starts out, the rest have to join through the leader
+			if r.ClusterSize() >= r.bootstrap {
+				break
+			}
+			if peer := r.AddPeer(addr); peer != nil {
+				log.Printf("KeepRegistered() | '%v' registered, cluster is %v replicas\n", addr, r.ClusterSize())
+			}
+		}
+		r.mutex.Unlock()
+	}
+}
+
+// replicas join & leave the cluster through membership entries in the log, each holding every member & the next epoch.
+// a replica uses the last membership in its log as soon as it has it - committed or not, like raft describes.
+// only one membership change can be in the log uncommitted at a time, so the old & new majorities always overlap
+
+// JoinCluster adds the replica at addr to the cluster, it is replicated to right away - & counts towards the majority
+func (r *Replica) JoinCluster(ctx context.Context, member *DAS.Member) (*DAS.Ack, error) {
+	log.Printf("JoinCluster() | Request received for '%v'\n", member.Addr)
+	ack := r.ChangeMembers(member.Addr, true)
+	log.Printf("JoinCluster() | Told client: %v\n", ack)
+	return Reply(ctx, ack)
+}
+
+// LeaveCluster removes the replica at addr from the cluster, a leader that leaves steps down once it is committed
+func (r *Replica) LeaveCluster(ctx context.Context, member *DAS.Member) (*DAS.Ack, error) {
+	log.Printf("LeaveCluster() | Request received for '%v'\n", member.Addr)
+	ack := r.ChangeMembers(member.Addr, false)
+	log.Printf("LeaveCluster() | Told client: %v\n", ack)
+	return Reply(ctx, ack)
+}
+
+// Members is answered by the leader, with the last membership that was committed
+func (r *Replica) Members(ctx context.Context, _ *DAS.Empty) (*DAS.Membership, error) {
+	if !r.ReadIndex() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		return &DAS.Membership{Leader: r.leader, Redirect: true}, nil
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	members := r.state.members
+	if r.state.epoch == 0 {
+		members = r.MemberList()
+	}
+	return &DAS.Membership{Epoch: r.state.epoch, Members: members}, nil
+}
+
+// appends the membership with addr added or removed, & waits for it to be applied
+func (r *Replica) ChangeMembers(addr string, join bool) *DAS.Ack {
+	r.mutex.Lock()
+	if r.role != LEADER {
+		ack := r.Redirect()
+		r.mutex.Unlock()
+		return ack
+	}
+	for index := r.commit + 1; index <= r.LastIndex(); index++ {
+		if r.Entry(index).Members != nil {
+			r.mutex.Unlock()
+			return &DAS.Ack{
+				Response: DAS.Acks_EXCEPTION,
+				Message:  "Another membership change is in progress, try again once it is committed",
+				Reason:   DAS.Reason_MEMBERSHIP_CHANGING,
+				Epoch:    r.state.epoch,
+			}
+		}
+	}
+	if r.IsMember(addr) == join {
+		r.mutex.Unlock()
+		return &DAS.Ack{
+			Response: DAS.Acks_SUCCESS,
+			Message:  "Membership is already like that",
+			Epoch:    r.state.epoch,
+		}
+	}
+	var members []string
+	for _, member := range r.MemberList() {
+		if member != addr {
+			members = append(members, member)
+		}
+	}
+	if join {
+		members = append(members, addr)
+		sort.Strings(members)
+	}
+	if len(members) == 0 {
+		r.mutex.Unlock()
+		return &DAS.Ack{
+			Response: DAS.Acks_EXCEPTION,
+			Message:  "The last replica cannot leave the cluster",
+			Reason:   DAS.Reason_LAST_MEMBER,
+			Epoch:    r.state.epoch,
+		}
+	}
+	return r.Await(&DAS.Entry{Members: &DAS.Membership{Epoch: r.epoch + 1, Members: members}})
+}
+
+// makes the peers the members of the last membership in the log, & disconnects from the ones that left.
+// a membership truncated away from our log is undone again - unless it was the first one, then the peers it added are kept.
+// has to be called while holding the mutex
+func (r *Replica) UpdatePeers() {
+	members, epoch := r.state.members, r.state.epoch
+	for index := r.LastIndex(); index > r.base; index-- {
+		if entry := r.Entry(index); entry.Members != nil {
+			members, epoch = entry.Members.Members, entry.Members.Epoch
+			break
+		}
+	}
+	if epoch == 0 || (epoch == r.epoch && strings.Join(members, ",") == strings.Join(r.members, ",")) {
+		return
+	}
+	r.members = append([]string{}, members...)
+	r.epoch = epoch
+	listed := make(map[string]bool)
+	for _, addr := range members {
+		listed[addr] = true
+		r.AddPeer(addr)
+	}
+	for addr := range r.peers {
+		if !listed[addr] {
+			r.RemovePeer(addr)
+		}
+	}
+	log.Printf("UpdatePeers() | Using membership epoch %v, members: %v\n", epoch, members)
+}
+
+// returns whether the replica at addr is in the cluster - has to be called while holding the mutex
+func (r *Replica) IsMember(addr string) bool {
+	if r.epoch == 0 {
+		_, ok := r.peers[addr]
+		return ok || (addr == r.addr && !r.joining)
+	}
+	return Contains(r.members, addr)
+}
+
+// returns whether we are waiting for the replicas the cluster starts out as to register, see KeepRegistered - has to be called while holding the mutex
+func (r *Replica) Bootstrapping() bool {
+	return r.epoch == 0 && r.ClusterSize() < r.bootstrap
+}
+
+func Contains(addrs []string, addr string) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// returns every replica in the cluster, sorted - has to be called while holding the mutex
+func (r *Replica) MemberList() []string {
+	if r.epoch > 0 {
+		return append([]string{}, r.members...)
+	}
+	var members []string
+	if !r.joining {
+		members = append(members, r.addr)
+	}
+	for addr := range r.peers {
+		members = append(members, addr)
+	}
+	sort.Strings(members)
+	return members
+}
+
+// asks the leader to add or remove us, through whichever peer knows who it is - false if noone could
+func (r *Replica) RequestMembership(join bool) bool {
+	r.mutex.Lock()
+	if r.role == LEADER {
+		r.mutex.Unlock()
+		return r.ChangeMembers(r.addr, join).Response == DAS.Acks_SUCCESS
+	}
+	peers := make(map[string]DAS.DASClient)
+	for addr, peer := range r.peers {
+		peers[addr] = peer
+	}
+	r.mutex.Unlock()
+
+	member := &DAS.Member{Addr: r.addr}
+	call := func(peer DAS.DASClient) *DAS.Ack {
+		ctx, cancel := context.WithTimeout(context.Background(), (WRITE_TIMEOUT+RPC_TIMEOUT)*time.Millisecond)
+		defer cancel()
+		var ack *DAS.Ack
+		var err error
+		if join {
+			ack, err = peer.JoinCluster(ctx, member)
+		} else {
+			ack, err = peer.LeaveCluster(ctx, member)
+		}
+		if err != nil {
+			return nil
+		}
+		return ack
+	}
+	for _, peer := range peers {
+		ack := call(peer)
+		if ack != nil && ack.Response == DAS.Acks_REDIRECT {
+			leader, ok := peers[ack.Leader]
+			if !ok {
+				continue
+			}
+			ack = call(leader)
+		}
+		if ack != nil && ack.Response == DAS.Acks_SUCCESS {
+			return true
+		}
+	}
+	return false
+}
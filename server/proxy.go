@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+)
+
+// a bidder can set the most they will pay for an item, & have the state bid for them whenever they are outbid - up to it.
+// after every accepted bid & maximum bid, the highest maximum outbids everyone else by the increment - & only by as much
+// as it takes, so the winner pays the increment over the second highest maximum. it is all worked out while applying
+// the entry, so every replica places the same bids. maximums are never sent to clients, only the bids placed with them
+
+// the most a bidder will pay for an item
+type proxy struct {
+	max   uint64
+	clock uint64 // lamport clock of the entry that set it, the earlier of two equal maximums wins
+}
+
+// how a maximum bid is encoded in snapshots
+type proxyRecord struct {
+	Bidder uint32 `json:"bidder"`
+	Max    uint64 `json:"max"`
+	Clock  uint64 `json:"clock"`
+}
+
+// returns the amount a bid, maximum bid, commitment or reveal entry is for, nil for any other entry
+func Bidding(entry *DAS.Entry) *DAS.Amount {
+	switch {
+	case entry.Bid != nil:
+		return entry.Bid
+	case entry.Commit != nil:
+		return entry.Commit
+	case entry.Reveal != nil:
+		return entry.Reveal
+	}
+	return entry.MaxBid
+}
+
+// returns the most the highest bidder will pay, at least the highest bid
+func (a *Auction) Ceiling() uint64 {
+	if p, ok := a.proxies[a.bidder]; ok && p.max > a.highestBid {
+		return p.max
+	}
+	return a.highestBid
+}
+
+func (s *State) applyMaxBid(amount *DAS.Amount, now time.Time, clock uint64) *DAS.Ack {
+	auction, ack := s.biddable(amount, clock)
+	if auction == nil {
+		return ack
+	} else if auction.format != DAS.Format_OPEN {
+		log.Printf("Apply() | Rejected maximum bid from %v on '%v' at clock %v, it is a %v auction\n", amount.Id, auction.id, clock, auction.format)
+		return &DAS.Ack{
+			Response: DAS.Acks_EXCEPTION,
+			Message:  "Auction does not take maximum bids",
+			Auction:  auction.id,
+			Reason:   DAS.Reason_NOT_SUPPORTED,
+		}
+	}
+	// the highest bidder can lower their maximum, but not below the bid they already have
+	if amount.Id == auction.bidder && amount.Bid < auction.highestBid {
+		log.Printf("Apply() | Rejected maximum bid from %v on '%v' at clock %v, it is below their bid of %v\n", amount.Id, auction.id, clock, auction.highestBid)
+		return auction.TooLow("Maximum bid is lower than your highest bid")
+	} else if amount.Id != auction.bidder && amount.Bid < auction.Minimum() {
+		log.Printf("Apply() | Rejected maximum bid from %v on '%v' at clock %v, lowest bid accepted is %v\n", amount.Id, auction.id, clock, auction.Minimum())
+		return auction.TooLow("Maximum bid is lower than the lowest bid accepted")
+	}
+	if auction.proxies == nil {
+		auction.proxies = make(map[uint32]*proxy)
+	}
+	auction.proxies[amount.Id] = &proxy{max: amount.Bid, clock: clock}
+	log.Printf("Apply() | Set maximum bid of %v on '%v' at clock %v (sent at %v)\n", amount.Id, auction.id, clock, amount.Clock)
+	auction.outbid(now, clock)
+	if auction.BuysNow(auction.highestBid) {
+		ack := s.buyNow(auction, now, clock)
+		if auction.bidder == amount.Id {
+			return ack
+		}
+	}
+	if auction.bidder != amount.Id {
+		return auction.TooLow("Maximum bid was outbid by a higher maximum bid")
+	}
+	return &DAS.Ack{
+		Response: DAS.Acks_SUCCESS,
+		Message:  "Maximum bid set, you are the highest bidder",
+		Auction:  auction.id,
+		Highest:  auction.highestBid,
+		Bidder:   auction.bidder,
+	}
+}
+
+// lets the maximum bids answer a bid that was just accepted, & tells the bidder if one of them outbid it
+func (s *State) answerBid(amount *DAS.Amount, ack *DAS.Ack, now time.Time, clock uint64) {
+	auction := s.byID[ack.Auction]
+	if auction.format != DAS.Format_OPEN || auction.closed {
+		return
+	}
+	auction.outbid(now, clock)
+	if auction.BuysNow(auction.highestBid) {
+		s.buyNow(auction, now, clock)
+	}
+	if auction.bidder != amount.Id {
+		*ack = *auction.TooLow("Bid was outbid by a maximum bid right away")
+	}
+}
+
+// has the highest maximum outbid every other bid, & drops the maximums that cannot bid anymore
+func (a *Auction) outbid(now time.Time, clock uint64) {
+	// the two highest maximums of everyone but the highest bidder, they never bid against themselves
+	var first, second *proxy
+	var challenger uint32
+	for bidder, p := range a.proxies {
+		if bidder == a.bidder {
+			continue
+		}
+		if first == nil || p.max > first.max || (p.max == first.max && p.clock < first.clock) {
+			second = first
+			first, challenger = p, bidder
+		} else if second == nil || p.max > second.max {
+			second = p
+		}
+	}
+	if first != nil && first.max >= a.Minimum() {
+		held := a.Ceiling()
+		if first.max > held {
+			// the challenger only has to beat whichever is higher, the highest bidders maximum or the next one after it
+			rival := held
+			if second != nil && second.max > rival {
+				rival = second.max
+			}
+			a.place(challenger, min(first.max, a.Above(rival)), now, clock)
+		} else if price := min(held, a.Above(first.max)); price > a.highestBid {
+			a.place(a.bidder, price, now, clock)
+		}
+	}
+	for bidder, p := range a.proxies {
+		if bidder != a.bidder && p.max < a.Minimum() {
+			delete(a.proxies, bidder)
+		}
+	}
+}
+
+// places a bid on the bidders behalf, & adds it to the ledger
+func (a *Auction) place(bidder uint32, amount uint64, now time.Time, clock uint64) {
+	log.Printf("Apply() | Placed bid of %v on '%v' for %v at clock %v, up to their maximum bid\n", amount, a.id, bidder, clock)
+	a.accept(bidder, amount, now, clock)
+	a.bids = append(a.bids, &DAS.BidRecord{
+		Bidder:   bidder,
+		Amount:   amount,
+		Time:     now.UnixMilli(),
+		Clock:    clock,
+		Accepted: true,
+		Proxy:    true,
+	})
+}
+
+func min(a uint64, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func encodeProxies(proxies map[uint32]*proxy) []proxyRecord {
+	var records []proxyRecord
+	for bidder, p := range proxies {
+		records = append(records, proxyRecord{Bidder: bidder, Max: p.max, Clock: p.clock})
+	}
+	// maps have no order, & every replica should write the same snapshot
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Bidder < records[j].Bidder
+	})
+	return records
+}
+
+func decodeProxies(records []proxyRecord) map[uint32]*proxy {
+	proxies := make(map[uint32]*proxy)
+	for _, record := range records {
+		proxies[record.Bidder] = &proxy{max: record.Max, clock: record.Clock}
+	}
+	return proxies
+}
+
+// SetMaxBid has the leader bid on the clients behalf, whenever it is outbid - up to the amount
+func (r *Replica) SetMaxBid(ctx context.Context, amount *DAS.Amount) (*DAS.Ack, error) {
+	log.Printf("SetMaxBid() | Request received from %v at clock %v\n", amount.Id, amount.Clock)
+	ack := r.Propose(&DAS.Entry{MaxBid: amount})
+	log.Printf("SetMaxBid() | Told %v: %v\n", amount.Id, ack)
+	return Reply(ctx, ack)
+}
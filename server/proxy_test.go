@@ -0,0 +1,38 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOutbid(t *testing.T) {
+	tests := []struct {
+		name    string
+		proxies map[uint32]*proxy
+		bidder  uint32
+		amount  uint64
+	}{
+		{"the higher maximum pays the increment over the lower", map[uint32]*proxy{1: {max: 50, clock: 3}, 2: {max: 80, clock: 5}}, 2, 51},
+		{"the earlier of equal maximums wins", map[uint32]*proxy{1: {max: 50, clock: 5}, 2: {max: 50, clock: 3}}, 2, 50},
+		{"a lone maximum bids the minimum", map[uint32]*proxy{1: {max: 50, clock: 3}}, 1, 11},
+		{"a maximum below the minimum does not bid", map[uint32]*proxy{1: {max: 10, clock: 3}}, 0, 10},
+	}
+	for _, test := range tests {
+		a := &Auction{id: "a", highestBid: 10, proxies: test.proxies}
+		a.outbid(time.UnixMilli(0), 10)
+		if a.bidder != test.bidder || a.highestBid != test.amount {
+			t.Errorf("%v: highest bid is %v by %v, want %v by %v", test.name, a.highestBid, a.bidder, test.amount, test.bidder)
+		}
+	}
+}
+
+func TestOutbidDropsMaximumsThatCannotBid(t *testing.T) {
+	a := &Auction{id: "a", highestBid: 10, proxies: map[uint32]*proxy{1: {max: 50, clock: 3}, 2: {max: 80, clock: 5}}}
+	a.outbid(time.UnixMilli(0), 10)
+	if _, ok := a.proxies[1]; ok {
+		t.Errorf("maximum of 50 was kept, below the minimum of %v", a.Minimum())
+	}
+	if _, ok := a.proxies[2]; !ok {
+		t.Errorf("maximum of the highest bidder was dropped")
+	}
+}
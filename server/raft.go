@@ -0,0 +1,681 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"sync"
+	"time"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+	"google.golang.org/grpc"
+)
+
+// this file is the consensus part of the replica - a plain implementation of raft.
+// every write is appended to the leaders log, replicated to the followers, & only applied
+// to the State once a majority of the cluster has it - so every replica applies the same entries in the same order
+
+type Role int
+
+const (
+	FOLLOWER Role = iota
+	CANDIDATE
+	LEADER
+)
+
+func (role Role) String() string {
+	switch role {
+	case LEADER:
+		return "leader"
+	case CANDIDATE:
+		return "candidate"
+	}
+	return "follower"
+}
+
+// a client waiting for the entry at some index to be applied
+type waiter struct {
+	term uint64 // term the entry was appended in, if another entry ends up at the index - the clients entry was lost
+	ack  chan *DAS.Ack
+}
+
+// appends an entry to the leaders log, & returns its index - has to be called while holding the mutex
+func (r *Replica) Append(entry *DAS.Entry) uint64 {
+	entry.Term = r.term
+	entry.Time = time.Now().UnixMilli()
+	entry.Clock = r.Witness(MessageClock(entry))
+	r.log = append(r.log, entry)
+	r.PersistEntries(r.LastIndex())
+	r.match[r.addr] = r.LastIndex()
+	if entry.Members != nil {
+		r.UpdatePeers()
+	}
+	for addr := range r.peers {
+		r.Kick(addr)
+	}
+	// a cluster of one has a majority by itself
+	r.AdvanceCommit()
+	return r.LastIndex()
+}
+
+// appends an entry, & waits for it to be applied - returning the outcome of applying it
+func (r *Replica) Propose(entry *DAS.Entry) *DAS.Ack {
+	r.mutex.Lock()
+	if r.role != LEADER {
+		r.Witness(MessageClock(entry))
+		ack := r.Redirect()
+		r.mutex.Unlock()
+		return ack
+	}
+	// the write could not be committed anyway, so the client is told right away instead of once it times out
+	if r.LostMajority() {
+		ack := &DAS.Ack{
+			Response: DAS.Acks_EXCEPTION,
+			Message:  fmt.Sprintf("Only %v of %v replicas are alive, a majority is needed", r.AliveMembers(), r.ClusterSize()),
+			Reason:   DAS.Reason_NO_MAJORITY,
+			Clock:    r.clock,
+			Epoch:    r.state.epoch,
+		}
+		r.mutex.Unlock()
+		return ack
+	}
+	// a retry of a bid we have already applied is answered right away, without going through the log again
+	amount := Bidding(entry)
+	if amount != nil {
+		if seen := r.state.Seen(amount, time.Now()); seen != nil {
+			r.mutex.Unlock()
+			return seen
+		}
+	}
+	// a bid reaching us after the auctions time is up, has to be ordered after its close - so the close is appended first.
+	// the same goes for a reveal reaching us after the time to reveal bids is up, & the reveal end
+	if amount != nil {
+		if auction := r.state.Get(amount.Auction); auction != nil {
+			r.CloseIfOver(auction, time.Now())
+			r.EndRevealIfOver(auction, time.Now())
+		}
+	}
+	return r.Await(entry)
+}
+
+// appends an entry, & waits for it to be applied - has to be called while holding the mutex, which it releases
+func (r *Replica) Await(entry *DAS.Entry) *DAS.Ack {
+	index := r.Append(entry)
+	w := waiter{term: r.term, ack: make(chan *DAS.Ack, 1)}
+	r.waiting[index] = w
+	r.mutex.Unlock()
+
+	select {
+	case ack := <-w.ack:
+		return ack
+	case <-time.After(WRITE_TIMEOUT * time.Millisecond):
+		r.mutex.Lock()
+		delete(r.waiting, index)
+		r.mutex.Unlock()
+		return &DAS.Ack{
+			Response: DAS.Acks_EXCEPTION,
+			Message:  "Timed out waiting for a majority of replicas",
+			Reason:   DAS.Reason_TIMED_OUT,
+		}
+	}
+}
+
+// waits until the replica knows it is still the leader, & has applied every entry that was committed when
+// the read started - so a read can never see state older than a write that has already been acknowledged
+func (r *Replica) ReadIndex() bool {
+	deadline := time.Now().Add(WRITE_TIMEOUT * time.Millisecond)
+	r.mutex.Lock()
+	// a new leader does not know what is committed, until an entry from its own term is
+	for r.role == LEADER && r.Term(r.commit) != r.term && time.Now().Before(deadline) {
+		r.mutex.Unlock()
+		time.Sleep(HEARTBEAT * time.Millisecond)
+		r.mutex.Lock()
+	}
+	if r.role != LEADER || r.Term(r.commit) != r.term {
+		r.mutex.Unlock()
+		return false
+	}
+	index := r.commit
+	r.mutex.Unlock()
+
+	if !r.ConfirmLeadership() {
+		return false
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for r.applied < index {
+		r.mutex.Unlock()
+		time.Sleep(time.Millisecond)
+		r.mutex.Lock()
+	}
+	return true
+}
+
+// sends a heartbeat to every peer, & returns whether a majority still accepts us as leader
+func (r *Replica) ConfirmLeadership() bool {
+	r.mutex.Lock()
+	term := r.term
+	requests := make(map[string]*DAS.Entries)
+	peers := make(map[string]DAS.DASClient)
+	for addr, peer := range r.peers {
+		requests[addr] = r.EntriesFor(addr, false)
+		peers[addr] = peer
+	}
+	r.mutex.Unlock()
+
+	var wg sync.WaitGroup
+	var votesMutex sync.Mutex
+	votes := 1
+	for addr, peer := range peers {
+		wg.Add(1)
+		go func(request *DAS.Entries, peer DAS.DASClient) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), RPC_TIMEOUT*time.Millisecond)
+			defer cancel()
+			reply, err := peer.AppendEntries(ctx, request)
+			if err != nil {
+				return
+			}
+			r.mutex.Lock()
+			r.StepDownIfBehind(reply.Term)
+			r.mutex.Unlock()
+			if reply.Term == term {
+				votesMutex.Lock()
+				votes++
+				votesMutex.Unlock()
+			}
+		}(requests[addr], peer)
+	}
+	wg.Wait()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.role == LEADER && r.term == term && votes > r.ClusterSize()/2
+}
+
+// RequestVote is sent by candidates, a vote is granted once per term & only to candidates whose log is at least as up to date as ours
+func (r *Replica) RequestVote(ctx context.Context, vote *DAS.Vote) (*DAS.VoteReply, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.StepDownIfBehind(vote.Term)
+
+	lastTerm := r.Term(r.LastIndex())
+	upToDate := vote.LastTerm > lastTerm || (vote.LastTerm == lastTerm && vote.LastIndex >= r.LastIndex())
+	if vote.Term < r.term || (r.votedFor != "" && r.votedFor != vote.Candidate) || !upToDate {
+		return &DAS.VoteReply{Term: r.term, Granted: false}, nil
+	}
+	r.votedFor = vote.Candidate
+	r.PersistState()
+	r.heard = time.Now()
+	log.Printf("RequestVote() | Voted for '%v' in term %v\n", vote.Candidate, vote.Term)
+	return &DAS.VoteReply{Term: r.term, Granted: true}, nil
+}
+
+// AppendEntries is sent by the leader, both for replicating entries & as heartbeat
+func (r *Replica) AppendEntries(ctx context.Context, request *DAS.Entries) (*DAS.EntriesReply, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if request.Term < r.term {
+		return &DAS.EntriesReply{Term: r.term, Success: false, LastIndex: r.LastIndex()}, nil
+	}
+	r.StepDownIfBehind(request.Term)
+	// a candidate that hears from the leader of its own term lost the election
+	r.role = FOLLOWER
+	if r.leader != request.Leader {
+		log.Printf("AppendEntries() | '%v' is leader in term %v\n", request.Leader, request.Term)
+		r.leader = request.Leader
+	}
+	r.heard = time.Now()
+
+	if request.PrevIndex > r.LastIndex() {
+		return &DAS.EntriesReply{Term: r.term, Success: false, LastIndex: r.LastIndex()}, nil
+	}
+	// entries up to base are already in our snapshot, & so were committed
+	for request.PrevIndex < r.base && len(request.Entries) > 0 {
+		request.PrevIndex++
+		request.PrevTerm = request.Entries[0].Term
+		request.Entries = request.Entries[1:]
+	}
+	if request.PrevIndex < r.base {
+		return &DAS.EntriesReply{Term: r.term, Success: true, LastIndex: r.LastIndex()}, nil
+	}
+	if r.Term(request.PrevIndex) != request.PrevTerm {
+		return &DAS.EntriesReply{Term: r.term, Success: false, LastIndex: request.PrevIndex - 1}, nil
+	}
+
+	// index of the first entry we did not already have, the ones from there on have to be persisted
+	persistFrom := r.LastIndex() + 1
+	for i, entry := range request.Entries {
+		index := request.PrevIndex + 1 + uint64(i)
+		if index <= r.LastIndex() {
+			if r.Term(index) == entry.Term {
+				continue
+			}
+			// conflicting entry, it & everything after it was never committed
+			r.log = r.log[:index-r.base]
+		}
+		if index < persistFrom {
+			persistFrom = index
+		}
+		r.log = append(r.log, entry)
+		// if we become leader, our entries have to get clocks after every entry the old leader appended
+		r.Witness(entry.Clock)
+	}
+	if persistFrom <= r.LastIndex() {
+		r.PersistEntries(persistFrom)
+		r.UpdatePeers()
+	}
+
+	if request.Commit > r.commit {
+		r.commit = request.Commit
+		if last := request.PrevIndex + uint64(len(request.Entries)); last < r.commit {
+			r.commit = last
+		}
+		r.ApplyCommitted()
+	}
+	return &DAS.EntriesReply{Term: r.term, Success: true, LastIndex: r.LastIndex()}, nil
+}
+
+// runs for as long as the replica does - starts elections when the leader is quiet, & lets the leader close auctions
+func (r *Replica) Ticker() {
+	timeout := ElectionTimeout()
+	for {
+		time.Sleep(10 * time.Millisecond)
+		r.mutex.Lock()
+		if r.role == LEADER {
+			r.CloseAuctions()
+		} else if time.Since(r.heard) > timeout || (time.Since(r.heard) > ELECTION_TIMEOUT*time.Millisecond && r.LeaderSuspected()) {
+			// a leader the failure detector believes is dead, is not waited on for the rest of the randomized timeout
+			timeout = ElectionTimeout()
+			// a replica that left the cluster, or has not joined it yet - must not disturb the ones in it.
+			// & noone leads a cluster that has not finished registering, a majority of it might not be one of the whole
+			if !r.IsMember(r.addr) || r.Bootstrapping() {
+				r.heard = time.Now()
+				r.mutex.Unlock()
+				continue
+			}
+			r.mutex.Unlock()
+			r.StartElection()
+			continue
+		}
+		r.mutex.Unlock()
+	}
+}
+
+// randomized, so replicas rarely become candidates at the same time
+func ElectionTimeout() time.Duration {
+	return time.Duration(ELECTION_TIMEOUT+rand.Intn(ELECTION_TIMEOUT)) * time.Millisecond
+}
+
+func (r *Replica) StartElection() {
+	r.mutex.Lock()
+	r.term++
+	r.role = CANDIDATE
+	r.votedFor = r.addr
+	r.PersistState()
+	r.leader = ""
+	r.heard = time.Now()
+	term := r.term
+	vote := &DAS.Vote{
+		Term:      r.term,
+		Candidate: r.addr,
+		LastIndex: r.LastIndex(),
+		LastTerm:  r.Term(r.LastIndex()),
+	}
+	log.Printf("StartElection() | Became candidate in term %v\n", term)
+	// peers can be added while we wait for votes, see members.go
+	var peers []DAS.DASClient
+	for _, peer := range r.peers {
+		peers = append(peers, peer)
+	}
+	r.mutex.Unlock()
+
+	// only touched while holding the mutex
+	votes := 1
+	for _, peer := range peers {
+		go func(peer DAS.DASClient) {
+			ctx, cancel := context.WithTimeout(context.Background(), RPC_TIMEOUT*time.Millisecond)
+			defer cancel()
+			reply, err := peer.RequestVote(ctx, vote)
+			if err != nil {
+				return
+			}
+			r.mutex.Lock()
+			defer r.mutex.Unlock()
+			r.StepDownIfBehind(reply.Term)
+			if !reply.Granted || r.role != CANDIDATE || r.term != term {
+				return
+			}
+			votes++
+			if votes > r.ClusterSize()/2 {
+				r.BecomeLeader()
+			}
+		}(peer)
+	}
+	// a cluster of one wins by itself
+	r.mutex.Lock()
+	if r.role == CANDIDATE && r.term == term && votes > r.ClusterSize()/2 {
+		r.BecomeLeader()
+	}
+	r.mutex.Unlock()
+}
+
+// has to be called while holding the mutex
+func (r *Replica) BecomeLeader() {
+	log.Printf("BecomeLeader() | Won election for term %v\n", r.term)
+	r.role = LEADER
+	r.leader = r.addr
+	r.leading = time.Now()
+	r.closing = make(map[string]bool)
+	r.ending = make(map[string]bool)
+	for addr := range r.peers {
+		r.next[addr] = r.LastIndex() + 1
+		r.match[addr] = 0
+	}
+	// entries from earlier terms can only be committed together with one from our own term, so a no-op is appended
+	r.Append(&DAS.Entry{})
+}
+
+// steps down to follower if someone has a newer term than us - has to be called while holding the mutex
+func (r *Replica) StepDownIfBehind(term uint64) {
+	if term <= r.term {
+		return
+	}
+	if r.role != FOLLOWER {
+		log.Printf("StepDownIfBehind() | Stepped down as %v, term %v is newer\n", r.role, term)
+	}
+	r.term = term
+	r.role = FOLLOWER
+	r.votedFor = ""
+	r.PersistState()
+	r.leader = ""
+}
+
+// InstallSnapshot is sent by the leader when we are so far behind, that the entries we need have been compacted away
+func (r *Replica) InstallSnapshot(ctx context.Context, snapshot *DAS.Snapshot) (*DAS.EntriesReply, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if snapshot.Term < r.term {
+		return &DAS.EntriesReply{Term: r.term, Success: false, LastIndex: r.LastIndex()}, nil
+	}
+	r.StepDownIfBehind(snapshot.Term)
+	r.role = FOLLOWER
+	r.leader = snapshot.Leader
+	r.heard = time.Now()
+	if r.Install(snapshot) {
+		log.Printf("InstallSnapshot() | Installed snapshot up to entry %v from '%v'\n", snapshot.LastIndex, snapshot.Leader)
+	}
+	return &DAS.EntriesReply{Term: r.term, Success: true, LastIndex: r.LastIndex()}, nil
+}
+
+// replaces state & log with a snapshot of committed entries, unless we have already applied them - has to be called while holding the mutex
+func (r *Replica) Install(snapshot *DAS.Snapshot) bool {
+	if snapshot.LastIndex <= r.commit {
+		return false
+	}
+	// entries after the snapshot are kept, if our log agrees with it
+	var keep []*DAS.Entry
+	if snapshot.LastIndex < r.LastIndex() && r.Term(snapshot.LastIndex) == snapshot.LastTerm {
+		keep = r.log[snapshot.LastIndex-r.base+1:]
+	}
+	r.wal.SaveSnapshot(&DAS.Snapshot{
+		LastIndex: snapshot.LastIndex,
+		LastTerm:  snapshot.LastTerm,
+		State:     snapshot.State,
+	})
+	r.InstallState(snapshot)
+	r.log = append(r.log, keep...)
+	r.RewriteWAL()
+	r.UpdatePeers()
+	return true
+}
+
+// runs for as long as the replica does, sending the log to one peer whenever new entries are appended or a heartbeat is due
+func (r *Replica) ReplicateTo(addr string, peer DAS.DASClient) {
+	r.mutex.Lock()
+	kick := r.kick[addr]
+	r.mutex.Unlock()
+	for {
+		select {
+		case <-kick:
+		case <-time.After(HEARTBEAT * time.Millisecond):
+		}
+		r.mutex.Lock()
+		// the peer left the cluster
+		if r.peers[addr] != peer {
+			r.mutex.Unlock()
+			return
+		}
+		if r.role != LEADER {
+			r.mutex.Unlock()
+			continue
+		}
+		term := r.term
+		// the entries the peer needs have been compacted away, so it gets our snapshot instead
+		if r.next[addr] <= r.base {
+			r.SendSnapshot(addr, peer, &DAS.Snapshot{
+				Term:      r.term,
+				Leader:    r.addr,
+				LastIndex: r.snapshot.LastIndex,
+				LastTerm:  r.snapshot.LastTerm,
+				State:     r.snapshot.State,
+			})
+			continue
+		}
+		request := r.EntriesFor(addr, true)
+		r.mutex.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), RPC_TIMEOUT*time.Millisecond)
+		reply, err := peer.AppendEntries(ctx, request)
+		cancel()
+		if err != nil {
+			continue
+		}
+
+		r.mutex.Lock()
+		r.StepDownIfBehind(reply.Term)
+		if r.role == LEADER && r.term == term {
+			if reply.Success {
+				r.match[addr] = request.PrevIndex + uint64(len(request.Entries))
+				r.next[addr] = r.match[addr] + 1
+				r.AdvanceCommit()
+				if r.next[addr] <= r.LastIndex() {
+					r.Kick(addr)
+				}
+			} else {
+				// skip back to where the followers log ends, & retry right away
+				next := r.next[addr] - 1
+				if reply.LastIndex+1 < next {
+					next = reply.LastIndex + 1
+				}
+				if next < 1 {
+					next = 1
+				}
+				r.next[addr] = next
+				r.Kick(addr)
+			}
+		}
+		r.mutex.Unlock()
+	}
+}
+
+// sends a snapshot of committed entries to a peer, & returns whether it was installed - has to be called while holding
+// the mutex, which it releases
+func (r *Replica) SendSnapshot(addr string, peer DAS.DASClient, snapshot *DAS.Snapshot) bool {
+	term := r.term
+	r.mutex.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), RPC_TIMEOUT*time.Millisecond)
+	reply, err := peer.InstallSnapshot(ctx, snapshot, grpc.WaitForReady(true))
+	cancel()
+	if err != nil {
+		return false
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.StepDownIfBehind(reply.Term)
+	if reply.Success && r.role == LEADER && r.term == term {
+		log.Printf("SendSnapshot() | Sent snapshot up to entry %v to '%v'\n", snapshot.LastIndex, addr)
+		r.match[addr] = snapshot.LastIndex
+		r.next[addr] = snapshot.LastIndex + 1
+		r.Kick(addr)
+		return true
+	}
+	return false
+}
+
+// builds the AppendEntries request for a peer, without entries it is just a heartbeat - has to be called while holding the mutex
+func (r *Replica) EntriesFor(addr string, withEntries bool) *DAS.Entries {
+	next := r.next[addr]
+	request := &DAS.Entries{
+		Term:      r.term,
+		Leader:    r.addr,
+		PrevIndex: next - 1,
+		PrevTerm:  r.Term(next - 1),
+		Commit:    r.commit,
+	}
+	if withEntries {
+		end := r.LastIndex() + 1
+		if end-next > MAX_ENTRIES {
+			end = next + MAX_ENTRIES
+		}
+		request.Entries = append([]*DAS.Entry{}, r.log[next-r.base:end-r.base]...)
+	}
+	return request
+}
+
+// wakes up the goroutine replicating to a peer, without blocking if it is already awake
+func (r *Replica) Kick(addr string) {
+	select {
+	case r.kick[addr] <- struct{}{}:
+	default:
+	}
+}
+
+// commits the newest entry from our term that a majority has - has to be called while holding the mutex
+func (r *Replica) AdvanceCommit() {
+	if r.Bootstrapping() {
+		return
+	}
+	for index := r.LastIndex(); index > r.commit; index-- {
+		// raft only commits entries from the current term by counting, older ones are committed along with them
+		if r.Term(index) != r.term {
+			break
+		}
+		count := 0
+		for addr, match := range r.match {
+			if match >= index && r.IsMember(addr) {
+				count++
+			}
+		}
+		if count > r.ClusterSize()/2 {
+			r.commit = index
+			r.ApplyCommitted()
+			break
+		}
+	}
+}
+
+// applies every committed entry that has not been applied yet, & answers the clients waiting on them - has to be called while holding the mutex
+func (r *Replica) ApplyCommitted() {
+	for r.applied < r.commit {
+		r.applied++
+		entry := r.Entry(r.applied)
+		ack := r.state.Apply(entry)
+		if auction, ok := r.state.byID[ack.Auction]; ok && Bidding(entry) != nil && auction.clock == entry.Clock {
+			// the highest bid was placed by the entry, by the bidder or on their behalf
+			r.Notify(DAS.Events_BID, ack.Auction)
+			// & the bid ended the auction right away
+			if auction.closedAt == entry.Clock {
+				r.NotifyClosed(ack.Auction)
+			}
+		} else if entry.Close != nil {
+			if _, live := r.state.live[entry.Close.Auction]; live {
+				// the auction was extended, so it has to be closed again once the extension is up
+				delete(r.closing, entry.Close.Auction)
+			} else {
+				r.NotifyClosed(entry.Close.Auction)
+			}
+		} else if entry.RevealEnd != nil {
+			if _, revealing := r.state.revealing[entry.RevealEnd.Auction]; revealing {
+				// appended by a leader whose clock was behind, so it has to be appended again once the time is up
+				delete(r.ending, entry.RevealEnd.Auction)
+			} else {
+				r.NotifyClosed(entry.RevealEnd.Auction)
+			}
+		} else if entry.Members != nil && r.role == LEADER && !r.IsMember(r.addr) {
+			// a leader that left keeps leading until its leaving is committed, so the rest of the cluster has it
+			log.Printf("ApplyCommitted() | Stepped down as leader, we left the cluster\n")
+			r.role = FOLLOWER
+			r.leader = ""
+		}
+		if w, ok := r.waiting[r.applied]; ok {
+			delete(r.waiting, r.applied)
+			if w.term != entry.Term {
+				ack = &DAS.Ack{
+					Response: DAS.Acks_EXCEPTION,
+					Message:  "Leader changed before the request was committed",
+					Reason:   DAS.Reason_LEADER_CHANGED,
+				}
+			}
+			w.ack <- ack
+		}
+	}
+	if r.applied-r.base >= SNAPSHOT_EVERY {
+		r.TakeSnapshot()
+	}
+}
+
+// proposes closing live auctions once their time is up, & ending the time to reveal bids on closed ones - only the
+// leaders clock decides when either is over - has to be called while holding the mutex
+func (r *Replica) CloseAuctions() {
+	now := time.Now()
+	for _, auction := range r.state.live {
+		r.CloseIfOver(auction, now)
+	}
+	for _, auction := range r.state.revealing {
+		r.EndRevealIfOver(auction, now)
+	}
+}
+
+// appends the close entry for an auction if its time is up, & it has not been appended already - has to be called while holding the mutex
+func (r *Replica) CloseIfOver(auction *Auction, now time.Time) {
+	if r.closing[auction.id] || !auction.Over(now) {
+		return
+	}
+	r.closing[auction.id] = true
+	r.Append(&DAS.Entry{Close: &DAS.Close{Auction: auction.id}})
+}
+
+// appends the reveal end for an auction if the time to reveal bids on it is up, & it has not been appended already - has to be called while holding the mutex
+func (r *Replica) EndRevealIfOver(auction *Auction, now time.Time) {
+	if _, revealing := r.state.revealing[auction.id]; !revealing || r.ending[auction.id] || now.Before(auction.RevealEnd()) {
+		return
+	}
+	r.ending[auction.id] = true
+	r.Append(&DAS.Entry{RevealEnd: &DAS.Close{Auction: auction.id}})
+}
+
+// the log starts at base, since entries up to it have been compacted into the snapshot
+func (r *Replica) LastIndex() uint64 {
+	return r.base + uint64(len(r.log)-1)
+}
+
+func (r *Replica) Entry(index uint64) *DAS.Entry {
+	return r.log[index-r.base]
+}
+
+func (r *Replica) Term(index uint64) uint64 {
+	return r.log[index-r.base].Term
+}
+
+// the amount of replicas in the cluster, including this one - unless it has left
+func (r *Replica) ClusterSize() int {
+	if r.epoch == 0 {
+		return len(r.peers) + 1
+	}
+	return len(r.members)
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/LocatedInSpace/Distributed-Auction-System/commitment"
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+)
+
+// a sealed auction with revealFor set takes commitments instead of bids, so not even the replicas know the bids while
+// it is live. once the close is applied, bidders have revealFor milliseconds from it to reveal the bid & nonce they
+// committed to. once the leader sees the time is up it appends the reveal end, & reveals applied after it are rejected -
+// so every replica agrees on which reveals were in time, without going by its own clock. the winner is worked out from
+// the reveals that match their commitment, & is only sent to clients once the reveal end is applied. a bidder that does
+// not reveal in time has not bid
+
+// the commitment a bidder sent, & the bid it was revealed as
+type commit struct {
+	hash     []byte
+	clock    uint64 // lamport clock of the commit entry, the earlier of two equal bids wins
+	amount   uint64
+	revealed bool // set when the bid was revealed, & was at least the lowest bid accepted
+}
+
+// how a commitment is encoded in snapshots
+type commitRecord struct {
+	Bidder   uint32 `json:"bidder"`
+	Hash     []byte `json:"hash"`
+	Clock    uint64 `json:"clock"`
+	Amount   uint64 `json:"amount"`
+	Revealed bool   `json:"revealed"`
+}
+
+// returns whether the auction takes commitments instead of bids
+func (a *Auction) CommitReveal() bool {
+	return a.Sealed() && a.revealFor > 0
+}
+
+// returns when the time to reveal bids is up - counted from when the close was applied, so a close applied late
+// (after a failover, say) does not cut it short. until it is applied, from when the auction is over
+func (a *Auction) RevealEnd() time.Time {
+	from := a.End()
+	if !a.closedTime.IsZero() {
+		from = a.closedTime
+	}
+	return from.Add(time.Duration(a.revealFor) * time.Millisecond)
+}
+
+// returns whether the winner of the auction is known at the given time - once it is over, & the reveal end was applied
+func (a *Auction) Decided(now time.Time) bool {
+	if a.CommitReveal() {
+		return a.revealedAt != 0
+	}
+	return a.Over(now)
+}
+
+// returns the bids that were revealed in time, earliest commitment first
+func (a *Auction) revealed() []*DAS.BidRecord {
+	var bids []*DAS.BidRecord
+	for bidder, c := range a.commits {
+		if c.revealed {
+			bids = append(bids, &DAS.BidRecord{Bidder: bidder, Amount: c.amount, Clock: c.clock, Accepted: true})
+		}
+	}
+	sort.Slice(bids, func(i, j int) bool {
+		return bids[i].Clock < bids[j].Clock
+	})
+	return bids
+}
+
+func (s *State) applyCommit(amount *DAS.Amount, clock uint64) *DAS.Ack {
+	auction, ack := s.biddable(amount, clock)
+	if auction == nil {
+		return ack
+	} else if !auction.CommitReveal() {
+		log.Printf("Apply() | Rejected commitment from %v on '%v' at clock %v, it takes bids\n", amount.Id, auction.id, clock)
+		return &DAS.Ack{
+			Response: DAS.Acks_EXCEPTION,
+			Message:  "Auction takes bids, not commitments",
+			Auction:  auction.id,
+			Reason:   DAS.Reason_NOT_SUPPORTED,
+		}
+	} else if len(amount.Commitment) != len(commitment.Hash("", 0, 0, nil)) {
+		return &DAS.Ack{
+			Response: DAS.Acks_EXCEPTION,
+			Message:  "Commitment is not a sha256 hash",
+			Auction:  auction.id,
+			Reason:   DAS.Reason_BAD_COMMITMENT,
+		}
+	} else if _, ok := auction.commits[amount.Id]; ok {
+		log.Printf("Apply() | Rejected commitment from %v on '%v' at clock %v, they already committed\n", amount.Id, auction.id, clock)
+		return &DAS.Ack{
+			Response: DAS.Acks_FAIL,
+			Message:  "Only one bid is allowed in a sealed auction",
+			Auction:  auction.id,
+			Reason:   DAS.Reason_ALREADY_BID,
+		}
+	}
+	if auction.commits == nil {
+		auction.commits = make(map[uint32]*commit)
+	}
+	auction.commits[amount.Id] = &commit{hash: amount.Commitment, clock: clock}
+	log.Printf("Apply() | Took commitment from %v on '%v' at clock %v (sent at %v)\n", amount.Id, auction.id, clock, amount.Clock)
+	return &DAS.Ack{
+		Response: DAS.Acks_SUCCESS,
+		Message:  "Commitment placed, reveal the bid once the auction is over",
+		Auction:  auction.id,
+	}
+}
+
+func (s *State) applyReveal(amount *DAS.Amount, clock uint64) *DAS.Ack {
+	auction := s.Get(amount.Auction)
+	if auction == nil {
+		log.Printf("Apply() | Told %v, no auction '%v'\n", amount.Id, amount.Auction)
+		return &DAS.Ack{
+			Response: DAS.Acks_EXCEPTION,
+			Message:  "No such auction to reveal a bid on",
+			Reason:   DAS.Reason_NO_AUCTION,
+			Auction:  amount.Auction,
+		}
+	}
+	fail := func(response DAS.Acks, reason DAS.Reason, message string) *DAS.Ack {
+		log.Printf("Apply() | Rejected reveal from %v on '%v' at clock %v, %v\n", amount.Id, auction.id, clock, reason)
+		return &DAS.Ack{Response: response, Message: message, Auction: auction.id, Reason: reason}
+	}
+	c, ok := auction.commits[amount.Id]
+	switch {
+	case !auction.CommitReveal():
+		return fail(DAS.Acks_EXCEPTION, DAS.Reason_NOT_SUPPORTED, "Auction takes bids, not commitments")
+	case !auction.closed:
+		return fail(DAS.Acks_FAIL, DAS.Reason_NOT_REVEALING, "Bids are revealed once the auction is over")
+	case auction.revealedAt != 0:
+		return fail(DAS.Acks_EXCEPTION, DAS.Reason_NOT_REVEALING, "Time to reveal bids is up")
+	case !ok:
+		return fail(DAS.Acks_FAIL, DAS.Reason_BAD_COMMITMENT, "No commitment to reveal")
+	case c.revealed:
+		return fail(DAS.Acks_FAIL, DAS.Reason_ALREADY_BID, "Bid was already revealed")
+	case !commitment.Verify(c.hash, auction.id, amount.Id, amount.Bid, amount.Nonce):
+		return fail(DAS.Acks_FAIL, DAS.Reason_BAD_COMMITMENT, "Bid & nonce do not match the commitment")
+	case amount.Bid < auction.Minimum():
+		return auction.TooLow("Bid is lower than the starting bid")
+	}
+	c.amount = amount.Bid
+	c.revealed = true
+	log.Printf("Apply() | Revealed bid from %v on '%v' at clock %v (sent at %v)\n", amount.Id, auction.id, clock, amount.Clock)
+	return &DAS.Ack{
+		Response: DAS.Acks_SUCCESS,
+		Message:  "Bid revealed, the winner is known once the time to reveal bids is up",
+		Auction:  auction.id,
+	}
+}
+
+// the leader appends the reveal end once the time to reveal bids is up by its clock - if a new leader appends it with
+// a time before that, it is ignored & appended again once the time is up
+func (s *State) applyRevealEnd(end *DAS.Close, now time.Time, clock uint64) {
+	auction, ok := s.revealing[end.Auction]
+	if !ok {
+		return
+	}
+	if now.Before(auction.RevealEnd()) {
+		log.Printf("Apply() | Ignored reveal end of auction '%v' at clock %v, bids are revealed until %v\n", auction.id, clock, auction.RevealEnd().Format("15:04:05.000"))
+		return
+	}
+	auction.revealedAt = clock
+	delete(s.revealing, auction.id)
+	auction.logWinner("Decided", clock)
+}
+
+func encodeCommits(commits map[uint32]*commit) []commitRecord {
+	var records []commitRecord
+	for bidder, c := range commits {
+		records = append(records, commitRecord{Bidder: bidder, Hash: c.hash, Clock: c.clock, Amount: c.amount, Revealed: c.revealed})
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Bidder < records[j].Bidder
+	})
+	return records
+}
+
+func decodeCommits(records []commitRecord) map[uint32]*commit {
+	commits := make(map[uint32]*commit)
+	for _, record := range records {
+		commits[record.Bidder] = &commit{hash: record.Hash, clock: record.Clock, amount: record.Amount, revealed: record.Revealed}
+	}
+	return commits
+}
+
+// CommitBid takes a commitment to a bid on a sealed auction, while it is live
+func (r *Replica) CommitBid(ctx context.Context, amount *DAS.Amount) (*DAS.Ack, error) {
+	log.Printf("CommitBid() | Request received from %v at clock %v\n", amount.Id, amount.Clock)
+	ack := r.Propose(&DAS.Entry{Commit: amount})
+	log.Printf("CommitBid() | Told %v: %v\n", amount.Id, ack)
+	return Reply(ctx, ack)
+}
+
+// RevealBid takes the bid a commitment was made to, once the auction is over
+func (r *Replica) RevealBid(ctx context.Context, amount *DAS.Amount) (*DAS.Ack, error) {
+	log.Printf("RevealBid() | Request received from %v at clock %v\n", amount.Id, amount.Clock)
+	ack := r.Propose(&DAS.Entry{Reveal: amount})
+	log.Printf("RevealBid() | Told %v: %v\n", amount.Id, ack)
+	return Reply(ctx, ack)
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LocatedInSpace/Distributed-Auction-System/commitment"
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+)
+
+func TestDecided(t *testing.T) {
+	start := time.UnixMilli(1_000_000)
+	end := start.Add(time.Second)
+	tests := []struct {
+		name       string
+		format     DAS.Format
+		revealFor  uint32
+		closed     bool
+		revealedAt uint64
+		at         time.Time
+		decided    bool
+	}{
+		{"live", DAS.Format_OPEN, 0, false, 0, start, false},
+		{"over", DAS.Format_OPEN, 0, false, 0, end, true},
+		{"revealing", DAS.Format_SECOND_PRICE, 2000, true, 0, end.Add(time.Second), false},
+		{"time to reveal is up, but the reveal end is not applied", DAS.Format_SECOND_PRICE, 2000, true, 0, end.Add(time.Hour), false},
+		{"reveal end is applied", DAS.Format_SECOND_PRICE, 2000, true, 7, end.Add(2 * time.Second), true},
+	}
+	for _, test := range tests {
+		a := &Auction{format: test.format, auctionStart: start, duration: 1000, revealFor: test.revealFor, closed: test.closed, revealedAt: test.revealedAt}
+		if decided := a.Decided(test.at); decided != test.decided {
+			t.Errorf("%v: Decided() = %v, want %v", test.name, decided, test.decided)
+		}
+	}
+}
+
+func TestRevealEnd(t *testing.T) {
+	s := NewState()
+	s.Apply(at(1, 0, &DAS.Entry{Auction: "a", Item: &DAS.Item{Name: "a", Start: 10, Alive: 1000, Format: DAS.Format_FIRST_PRICE, RevealFor: 1000}}))
+	for id := uint32(1); id <= 2; id++ {
+		s.Apply(at(uint64(id+1), 10, &DAS.Entry{Commit: &DAS.Amount{Id: id, Auction: "a", Commitment: commitment.Hash("a", id, 20*uint64(id), []byte("nonce"))}}))
+	}
+	a := s.Get("a")
+	steps := []struct {
+		name     string
+		entry    *DAS.Entry
+		response DAS.Acks
+		decided  bool
+	}{
+		{"the close starts the time to reveal bids", at(4, 1500, &DAS.Entry{Close: &DAS.Close{Auction: "a"}}), DAS.Acks_SUCCESS, false},
+		{"a reveal in time is taken", at(5, 2000, &DAS.Entry{Reveal: &DAS.Amount{Id: 1, Bid: 20, Auction: "a", Nonce: []byte("nonce")}}), DAS.Acks_SUCCESS, false},
+		{"a reveal end before the time is up is ignored", at(6, 2400, &DAS.Entry{RevealEnd: &DAS.Close{Auction: "a"}}), DAS.Acks_SUCCESS, false},
+		{"a reveal after the time is up is taken until the reveal end", at(7, 2600, &DAS.Entry{Reveal: &DAS.Amount{Id: 2, Bid: 40, Auction: "a", Nonce: []byte("nonce")}}), DAS.Acks_SUCCESS, false},
+		{"the reveal end decides the auction", at(8, 2700, &DAS.Entry{RevealEnd: &DAS.Close{Auction: "a"}}), DAS.Acks_SUCCESS, true},
+	}
+	for _, step := range steps {
+		if ack := s.Apply(step.entry); ack.Response != step.response || a.Decided(epoch) != step.decided {
+			t.Errorf("%v: Apply() = %v, decided %v - want %v, %v", step.name, ack, a.Decided(epoch), step.response, step.decided)
+		}
+	}
+	if bidder, amount, _ := a.Winner(); bidder != 2 || amount != 40 {
+		t.Errorf("Winner() = %v, %v - want 2, 40", bidder, amount)
+	}
+	if ack := s.Apply(at(9, 2800, &DAS.Entry{Reveal: &DAS.Amount{Id: 1, Bid: 20, Auction: "a", Nonce: []byte("nonce")}})); ack.Reason != DAS.Reason_NOT_REVEALING {
+		t.Errorf("Apply() of a reveal after the reveal end = %v, want %v", ack, DAS.Reason_NOT_REVEALING)
+	}
+}
+
+func TestWinnerOfRevealedBids(t *testing.T) {
+	a := &Auction{format: DAS.Format_SECOND_PRICE, highestBid: 10, revealFor: 2000, commits: map[uint32]*commit{
+		1: {clock: 5, amount: 80, revealed: true},
+		2: {clock: 3, amount: 80, revealed: true},
+		3: {clock: 4, amount: 90},
+	}}
+	// the bid of 90 was never revealed, & of the two bids of 80 the earlier commitment wins
+	bidder, amount, price := a.Winner()
+	if bidder != 2 || amount != 80 || price != 80 {
+		t.Errorf("Winner() = %v, %v, %v - want 2, 80, 80", bidder, amount, price)
+	}
+}
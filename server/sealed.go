@@ -0,0 +1,120 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+)
+
+// in a sealed auction every bidder bids once, without knowing what anyone else bid. the bids are kept in the ledger like
+// any other, but the auction itself is not changed by them - so nothing about them is sent to clients until it is over.
+// once it is, the highest bid wins (the earlier one if they are equal) & pays what it bid in a first price auction -
+// or in a second price (vickrey) auction what the next highest bid was, at least the starting bid & the reserve
+
+// returns whether the bids on the auction are kept hidden until it is over
+func (a *Auction) Sealed() bool {
+	return a.format == DAS.Format_FIRST_PRICE || a.format == DAS.Format_SECOND_PRICE
+}
+
+// returns who won the auction, the bid they won with & the price they pay - for a sealed auction it is only known once it is over
+func (a *Auction) Winner() (uint32, uint64, uint64) {
+	if !a.Sealed() {
+		return a.bidder, a.highestBid, a.highestBid
+	}
+	bids := a.bids
+	if a.CommitReveal() {
+		bids = a.revealed()
+	}
+	var winner, second *DAS.BidRecord
+	for _, bid := range bids {
+		if !bid.Accepted {
+			continue
+		}
+		if winner == nil || bid.Amount > winner.Amount {
+			second = winner
+			winner = bid
+		} else if second == nil || bid.Amount > second.Amount {
+			second = bid
+		}
+	}
+	if winner == nil {
+		return 0, a.highestBid, 0
+	}
+	if a.format == DAS.Format_FIRST_PRICE {
+		return winner.Bidder, winner.Amount, winner.Amount
+	}
+	// highestBid is the starting bid, since sealed bids do not change it
+	price := a.highestBid
+	if a.reserve > price {
+		price = a.reserve
+	}
+	if second != nil && second.Amount > price {
+		price = second.Amount
+	}
+	return winner.Bidder, winner.Amount, min(price, winner.Amount)
+}
+
+// returns whether the bidder has already bid on a sealed auction
+func (a *Auction) HasBid(bidder uint32) bool {
+	for _, bid := range a.bids {
+		if bid.Accepted && bid.Bidder == bidder {
+			return true
+		}
+	}
+	return false
+}
+
+// keeps a bid on a sealed auction, without changing the auction - see Winner
+func (a *Auction) seal(amount *DAS.Amount, clock uint64) *DAS.Ack {
+	if a.CommitReveal() {
+		log.Printf("Apply() | Rejected bid from %v on '%v' at clock %v, it takes commitments\n", amount.Id, a.id, clock)
+		return &DAS.Ack{
+			Response: DAS.Acks_EXCEPTION,
+			Message:  "Auction takes commitments, not bids",
+			Auction:  a.id,
+			Reason:   DAS.Reason_NOT_SUPPORTED,
+		}
+	}
+	if a.HasBid(amount.Id) {
+		log.Printf("Apply() | Rejected sealed bid from %v on '%v' at clock %v, they already bid\n", amount.Id, a.id, clock)
+		return &DAS.Ack{
+			Response: DAS.Acks_FAIL,
+			Message:  "Only one bid is allowed in a sealed auction",
+			Auction:  a.id,
+			Reason:   DAS.Reason_ALREADY_BID,
+		}
+	}
+	if amount.Bid < a.Minimum() {
+		log.Printf("Apply() | Rejected sealed bid from %v on '%v' at clock %v, lowest bid accepted is %v\n", amount.Id, a.id, clock, a.Minimum())
+		return a.TooLow("Bid is lower than the starting bid")
+	}
+	log.Printf("Apply() | Sealed bid from %v on '%v' at clock %v (sent at %v)\n", amount.Id, a.id, clock, amount.Clock)
+	return &DAS.Ack{
+		Response: DAS.Acks_SUCCESS,
+		Message:  "Sealed bid placed, the winner is revealed once the auction is over",
+		Auction:  a.id,
+	}
+}
+
+// returns the ledger of an auction as clients may see it - the amounts of a sealed auction are hidden until the winner is known
+func (a *Auction) Ledger(start int, end int, now time.Time) []*DAS.BidRecord {
+	if !a.Sealed() || (a.closed && a.Decided(now)) {
+		return a.bids[start:end]
+	}
+	bids := make([]*DAS.BidRecord, 0, end-start)
+	for _, bid := range a.bids[start:end] {
+		bids = append(bids, &DAS.BidRecord{
+			Bidder:     bid.Bidder,
+			Time:       bid.Time,
+			Clock:      bid.Clock,
+			Accepted:   bid.Accepted,
+			Reason:     bid.Reason,
+			Request:    bid.Request,
+			Commitment: bid.Commitment,
+			Reveal:     bid.Reveal,
+			Maximum:    bid.Maximum,
+		})
+	}
+	return bids
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"testing"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+)
+
+func TestWinner(t *testing.T) {
+	bid := func(bidder uint32, amount uint64) *DAS.BidRecord {
+		return &DAS.BidRecord{Bidder: bidder, Amount: amount, Accepted: true}
+	}
+	tests := []struct {
+		name    string
+		format  DAS.Format
+		start   uint64
+		reserve uint64
+		bids    []*DAS.BidRecord
+		bidder  uint32
+		amount  uint64
+		price   uint64
+	}{
+		{"no bids", DAS.Format_SECOND_PRICE, 10, 0, nil, 0, 10, 0},
+		{"first price pays its bid", DAS.Format_FIRST_PRICE, 10, 0, []*DAS.BidRecord{bid(1, 50), bid(2, 80)}, 2, 80, 80},
+		{"second price pays the second bid", DAS.Format_SECOND_PRICE, 10, 0, []*DAS.BidRecord{bid(1, 50), bid(2, 80), bid(3, 60)}, 2, 80, 60},
+		{"second price pays at least the start", DAS.Format_SECOND_PRICE, 10, 0, []*DAS.BidRecord{bid(1, 80)}, 1, 80, 10},
+		{"second price pays at least the reserve", DAS.Format_SECOND_PRICE, 10, 30, []*DAS.BidRecord{bid(1, 80), bid(2, 20)}, 1, 80, 30},
+		{"second price never pays more than its bid", DAS.Format_SECOND_PRICE, 10, 30, []*DAS.BidRecord{bid(1, 20)}, 1, 20, 20},
+		{"the earlier of equal bids wins", DAS.Format_SECOND_PRICE, 10, 0, []*DAS.BidRecord{bid(1, 80), bid(2, 80)}, 1, 80, 80},
+		{"bids turned down are left out", DAS.Format_FIRST_PRICE, 10, 0, []*DAS.BidRecord{{Bidder: 1, Amount: 90}, bid(2, 40)}, 2, 40, 40},
+		{"open auctions go by the highest bid", DAS.Format_OPEN, 10, 0, nil, 0, 10, 10},
+	}
+	for _, test := range tests {
+		a := &Auction{format: test.format, highestBid: test.start, reserve: test.reserve, bids: test.bids}
+		bidder, amount, price := a.Winner()
+		if bidder != test.bidder || amount != test.amount || price != test.price {
+			t.Errorf("%v: Winner() = %v, %v, %v - want %v, %v, %v", test.name, bidder, amount, price, test.bidder, test.amount, test.price)
+		}
+	}
+}
+
+func TestSealAcceptsTheStartingBid(t *testing.T) {
+	a := &Auction{id: "a", format: DAS.Format_FIRST_PRICE, highestBid: 10}
+	if ack := a.seal(&DAS.Amount{Id: 1, Bid: 9}, 1); ack.Reason != DAS.Reason_BID_TOO_LOW {
+		t.Errorf("seal() of a bid below the start = %v, want BID_TOO_LOW", ack)
+	}
+	if ack := a.seal(&DAS.Amount{Id: 1, Bid: 10}, 2); ack.Response != DAS.Acks_SUCCESS {
+		t.Errorf("seal() of the starting bid = %v, want SUCCESS", ack)
+	}
+}
@@ -5,235 +5,267 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"net"
+	"math/rand"
 	"os"
 	"sync"
 	"time"
 
 	// this has to be the same as the go.mod module,
 	// followed by the path to the folder the proto file is in.
+	"github.com/LocatedInSpace/Distributed-Auction-System/discovery"
 	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+	"github.com/google/uuid"
 	"google.golang.org/grpc"
 )
 
-const BASEPORT = 7000         // port offset to start servers from
-const DELAYED_MUTEX = true    // this setting makes it way more likely for servers to stay in sync
-const PRECISE_LOGGING = false // ups precision on timestamps
+// defaults for the settings in config.go, which can be changed without editing these
+const BASEPORT = 7000               // port offset to start servers from
+const REPLICAS = 4                  // amount of replicas in the cluster, used for finding the other replicas
+const PRECISE_LOGGING = false       // ups precision on timestamps
+const DISCOVERY = "static"          // how replicas find each other, see members.go
+const MEMBERS_FILE = "members.json" // membership file replicas register in, with file discovery
+
+const HEARTBEAT = 50         // milliseconds between each heartbeat the leader sends to the followers
+const ELECTION_TIMEOUT = 300 // minimum milliseconds without hearing from a leader before starting an election, randomized up to double
+const RPC_TIMEOUT = 250      // milliseconds before a call to another replica is given up on
+const WRITE_TIMEOUT = 2000   // milliseconds a client waits for its write to be committed
+const MAX_ENTRIES = 64       // max amount of entries sent in a single AppendEntries
+const SNAPSHOT_EVERY = 1000  // applied entries between each snapshot, see wal.go
+const CATCHUP_TIMEOUT = 1000 // milliseconds a starting replica waits for each peer to transfer its state
+const WATCH_TICK = 1000      // milliseconds between each time left event sent to watchers
+const WATCH_BUFFER = 64      // events buffered for each watcher, before events are dropped
+const DEFAULT_PAGE_SIZE = 20 // bids or auctions on a page, when the client does not say
+const MAX_PAGE_SIZE = 100    // most bids or auctions on a page, see ledger.go
+const DEDUPE_TTL = 600000    // milliseconds the ack of a keyed bid is kept, so a retry within it is not applied twice - see dedupe.go
+const DETECT_EVERY = 100     // milliseconds between each heartbeat sent to every peer by the failure detector, see detector.go
+const DETECT_WINDOW = 100    // heartbeat replies the failure detector keeps the intervals between, for each peer
+const PHI_THRESHOLD = 8.0    // suspicion above which a peer is believed dead
+const MIN_DEVIATION = 50.0   // milliseconds the intervals between replies are assumed to vary by at least, so a steady peer is not suspected over a single hiccup
 
 type Replica struct {
 	DAS.UnimplementedDASServer
-	port     uint16     // used for logging
-	mutex    sync.Mutex // used to lock the server to avoid race conditions.
-	auctions []Auction
-}
+	port  uint16     // used for logging
+	addr  string     // address other replicas & clients know this replica by
+	mutex sync.Mutex // used to lock the server to avoid race conditions.
+	state State      // the auctions, only ever changed by applying committed entries
+
+	peers map[string]DAS.DASClient    // every other replica in the cluster, by address
+	conns map[string]*grpc.ClientConn // the connection to each peer, closed when it leaves the cluster
+	kick  map[string]chan struct{}    // wakes up the goroutine replicating to a peer
+
+	// the membership in use, from the last membership entry in the log - see members.go
+	members []string // every replica in the cluster, unused while epoch is 0 - then it is us & our peers
+	epoch   uint64
+	joining bool // we are not in the configured peers, so we are not a member until the cluster adds us
+	// with file discovery, how many replicas the cluster starts out as - noone leads until that many have registered,
+	// & the ones registering after them join through the leader. 0 with static discovery, the peers are known up front
+	bootstrap int
+
+	// raft state, see raft.go
+	term     uint64
+	votedFor string // who we voted for in term, empty if noone
+	leader   string // address of the leader in term, empty while unknown
+	role     Role
+	heard    time.Time     // last time we heard from a leader, or granted a vote
+	log      []*DAS.Entry  // log[0] is the entry at index base, only its term is kept
+	base     uint64        // index of log[0], every entry up to it is in the snapshot
+	commit   uint64        // index of the last entry known to be on a majority of replicas
+	applied  uint64        // index of the last entry applied to state
+	snapshot *DAS.Snapshot // the last snapshot taken or installed, nil if there is none
+	wal      *WAL
+	clock    uint64 // lamport clock, see clock.go
+
+	watchers map[string]map[chan *DAS.Event]bool // clients watching each auction, see watch.go
+	detector *Detector                           // which peers are alive, see detector.go
 
-type Auction struct {
-	highestBid   uint64
-	bidder       uint32
-	item         string
-	auctionStart time.Time
-	duration     uint32
+	// only used while leader
+	next    map[string]uint64 // index of the next entry to send to each peer
+	match   map[string]uint64 // index of the last entry each replica is known to have
+	waiting map[uint64]waiter // clients waiting for the entry at an index to be applied
+	closing map[string]bool   // auctions we have already appended a close entry for
+	ending  map[string]bool   // auctions we have already appended a reveal end for, see reveal.go
+	leading time.Time         // when we became leader
 }
 
 func main() {
-	var port uint16 = BASEPORT
-	started := false
-	var list net.Listener
-	var err error
-	// loop for starting server up on next free port (BASEPORT++)
-	for !started {
-		list, err = net.Listen("tcp", fmt.Sprintf("localhost:%v", port))
-		if err != nil {
-			log.Printf("Could not open listener on port %v, retrying port++\n", port)
-			port++
-		} else {
-			started = true
-		}
-	}
-	log.Printf("Created listener on port %v\n", port)
+	cfg := LoadConfig()
+	// starts the server up on the next free address in peers
+	list, addr, port := Listen(cfg)
+	log.Printf("Created listener on %v\n", addr)
 
-	f := setLog(port)
+	f := setLog(port, cfg.PreciseLogging)
 	defer f.Close()
 
 	grpcServer := grpc.NewServer()
 
 	server := &Replica{
-		port: port,
-	}
+		port:    port,
+		addr:    addr,
+		peers:   make(map[string]DAS.DASClient),
+		conns:   make(map[string]*grpc.ClientConn),
+		kick:    make(map[string]chan struct{}),
+		heard:   time.Now(),
+		log:     []*DAS.Entry{{}},
+		next:    make(map[string]uint64),
+		match:   make(map[string]uint64),
+		waiting: make(map[uint64]waiter),
+		state:   NewState(),
+		closing: make(map[string]bool),
+		ending:  make(map[string]bool),
+		wal:     OpenWAL(port),
 
+		watchers: make(map[string]map[chan *DAS.Event]bool),
+		detector: NewDetector(),
+	}
+	server.Recover()
+	server.joining = cfg.Discovery == "static" && !Contains(cfg.Peers, addr)
+	if server.joining {
+		log.Printf("'%v' is not in peers, it has to join the cluster (JoinCluster) before it takes part\n", addr)
+	}
+	server.ConnectPeers(cfg.Peers)
+	members, ok := Resolver(cfg).(discovery.File)
+	if ok {
+		server.bootstrap = cfg.Replicas
+		server.Join(members)
+	}
 	DAS.RegisterDASServer(grpcServer, server) //Registers the server to the gRPC server.
 
-	log.Printf("Replica started on %v\n", list.Addr())
+	// the leader sends its state to our address, so we are already listening while we catch up - clients are
+	// redirected until we know who the leader is, & we do not stand for election before we have caught up
+	go func() {
+		server.CatchUp()
+		log.Printf("Replica started on %v\n", list.Addr())
+
+		rand.Seed(time.Now().UnixNano())
+		go server.Ticker()
+		go server.Detect()
+		if ok {
+			go server.KeepRegistered(members)
+		}
+	}()
 
 	if err := grpcServer.Serve(list); err != nil {
 		log.Fatalf("Failed to serve %v", err)
 	}
 }
 
-// func (r *Replica) Bid(ctx context.Context, amount *DAS.Amount) (*DAS.Ack, error) {
+// writes are appended to the replicated log by the leader, & answered once they have been applied
 func (r *Replica) Bid(ctx context.Context, amount *DAS.Amount) (*DAS.Ack, error) {
-	r.mutex.Lock()
-	log.Printf("Bid() | Request received from %v, amount: %v\n", amount.Id, amount.Bid)
-	// no active auctions
-	if len(r.auctions) == 0 {
-		log.Printf("Bid() | Told %v, no active auctions\n", amount.Id)
-		if DELAYED_MUTEX {
-			go r.DelayedUnlock()
-		} else {
-			r.mutex.Unlock()
-		}
-		return &DAS.Ack{
-			Response: DAS.Acks_EXCEPTION,
-			Message:  "No active auction to bid on",
-		}, nil
-	} else {
-		// notice we use a reference, which means changes to lastAuction get "saved"
-		lastAuction := &r.auctions[len(r.auctions)-1]
-		now := time.Now()
-		difference := now.Sub(lastAuction.auctionStart)
-		// last auction is over
-		if difference.Milliseconds() > int64(lastAuction.duration) {
-			log.Printf("Bid() | Told %v, auction is over\n", amount.Id)
-			if DELAYED_MUTEX {
-				go r.DelayedUnlock()
-			} else {
-				r.mutex.Unlock()
-			}
-			return &DAS.Ack{
-				Response: DAS.Acks_EXCEPTION,
-				Message:  "Auction is over",
-			}, nil
-		} else {
-			if amount.Bid > lastAuction.highestBid {
-				lastAuction.bidder = amount.Id
-				lastAuction.highestBid = amount.Bid
-				log.Printf("Bid() | Accepted bid from %v\n", amount.Id)
-				if DELAYED_MUTEX {
-					go r.DelayedUnlock()
-				} else {
-					r.mutex.Unlock()
-				}
-				return &DAS.Ack{
-					Response: DAS.Acks_SUCCESS,
-					Message:  "Bid increased",
-				}, nil
-			} else {
-				log.Printf("Bid() | Rejected bid from %v\n", amount.Id)
-				if DELAYED_MUTEX {
-					go r.DelayedUnlock()
-				} else {
-					r.mutex.Unlock()
-				}
-				return &DAS.Ack{
-					Response: DAS.Acks_FAIL,
-					Message:  "Bid is lower than the highest bid",
-				}, nil
-			}
-		}
-	}
+	log.Printf("Bid() | Request received from %v at clock %v, amount: %v\n", amount.Id, amount.Clock, amount.Bid)
+	ack := r.Propose(&DAS.Entry{Bid: amount})
+	log.Printf("Bid() | Told %v: %v\n", amount.Id, ack)
+	return Reply(ctx, ack)
 }
 
-func (r *Replica) Result(ctx context.Context, _ *DAS.Empty) (*DAS.Outcome, error) {
+func (r *Replica) Result(ctx context.Context, query *DAS.Query) (*DAS.Outcome, error) {
+	if !r.ReadIndex() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		log.Printf("Result() | Redirected client to leader '%v'\n", r.leader)
+		return &DAS.Outcome{Leader: r.leader, Redirect: true}, nil
+	}
 	r.mutex.Lock()
-	// there exist no auctions, so return empty outcome
-	if len(r.auctions) == 0 {
-		log.Printf("Result() | Told client that there have been no auctions\n")
-		if DELAYED_MUTEX {
-			go r.DelayedUnlock()
-		} else {
-			r.mutex.Unlock()
+	defer r.mutex.Unlock()
+	auction := r.state.Get(query.Auction)
+	// there exist no such auction, so return empty outcome
+	if auction == nil {
+		log.Printf("Result() | Told client that there is no auction '%v'\n", query.Auction)
+		if WantsStatus(ctx) {
+			return nil, Failed("No such auction", &DAS.Failure{Reason: DAS.Reason_NO_AUCTION, Auction: query.Auction, Epoch: r.state.epoch})
 		}
 		return &DAS.Outcome{}, nil
+	}
+	outcome := auction.Outcome(time.Now())
+	outcome.Epoch = r.state.epoch
+	// auction is over
+	if outcome.Left == 0 {
+		log.Printf("Result() | Sent auction '%v', '%s' lasted %vms, won by id %v\n", auction.id, auction.item, auction.duration, outcome.Bidder)
 	} else {
-		lastAuction := r.auctions[len(r.auctions)-1]
-		now := time.Now()
-		difference := now.Sub(lastAuction.auctionStart)
-		var left uint32
-		// last auction is over
-		if difference.Milliseconds() >= int64(lastAuction.duration) {
-			log.Printf("Result() | Sent last auction, '%s' lasted %vms, won by id %v\n", lastAuction.item, lastAuction.duration, lastAuction.bidder)
-			left = 0
-		} else {
-			log.Printf("Result() | Sent current auction, '%s' lasts %vms, id %v is winning\n", lastAuction.item, lastAuction.duration, lastAuction.bidder)
-			left = lastAuction.duration - uint32(difference.Milliseconds())
-		}
-
-		if DELAYED_MUTEX {
-			go r.DelayedUnlock()
-		} else {
-			r.mutex.Unlock()
-		}
-		return &DAS.Outcome{
-			Left:   left,
-			Amount: lastAuction.highestBid,
-			Bidder: lastAuction.bidder,
-			Item:   lastAuction.item,
-		}, nil
+		log.Printf("Result() | Sent auction '%v', '%s' lasts %vms, id %v is winning\n", auction.id, auction.item, auction.duration, auction.bidder)
 	}
+	return outcome, nil
 }
 
 func (r *Replica) StartAuction(ctx context.Context, item *DAS.Item) (*DAS.Ack, error) {
+	log.Printf("Auction() | Request received for '%v' at clock %v, duration: %v\n", item.Name, item.Clock, item.Alive)
+	// the id is picked before the entry is appended, so every replica gives the auction the same one
+	ack := r.Propose(&DAS.Entry{Item: item, Auction: uuid.New().String()})
+	log.Printf("Auction() | Told client: %v\n", ack)
+	return Reply(ctx, ack)
+}
+
+func (r *Replica) Ping(ctx context.Context, _ *DAS.Empty) (*DAS.Empty, error) {
+	return &DAS.Empty{}, nil
+}
+
+// has to be called while holding the mutex
+func (r *Replica) Redirect() *DAS.Ack {
+	message := "Replica is a follower, send requests to the leader"
+	// no leader can be elected, so the client should know it is not just waiting on an election
+	if !r.HasMajority() {
+		message = fmt.Sprintf("Replica is a follower, & only %v of %v replicas are alive - a majority is needed", r.AliveMembers(), r.ClusterSize())
+	}
+	return &DAS.Ack{
+		Response: DAS.Acks_REDIRECT,
+		Message:  message,
+		Leader:   r.leader,
+		Clock:    r.clock,
+		Epoch:    r.state.epoch,
+	}
+}
+
+// dials every other replica in the cluster, without blocking - so replicas that
+// have not been started yet, are connected to once they are
+func (r *Replica) ConnectPeers(addrs []string) {
 	r.mutex.Lock()
-	// there exist no auctions, no need to check if last one is active
-	if len(r.auctions) == 0 {
-		log.Printf("Auction() | Started auction '%v', duration: %v\n", item.Name, item.Alive)
-		r.auctions = append(r.auctions,
-			Auction{
-				highestBid:   item.Start,
-				bidder:       0,
-				item:         item.Name,
-				auctionStart: time.Now(),
-				duration:     item.Alive,
-			})
-	} else {
-		lastAuction := r.auctions[len(r.auctions)-1]
-		now := time.Now()
-		difference := now.Sub(lastAuction.auctionStart)
-		// last auction is over, so we just append this as current auction
-		if difference.Milliseconds() > int64(lastAuction.duration) {
-			log.Printf("Auction() | Started auction '%v', duration: %v\n", item.Name, item.Alive)
-			r.auctions = append(r.auctions,
-				Auction{
-					highestBid:   item.Start,
-					bidder:       0,
-					item:         item.Name,
-					auctionStart: time.Now(),
-					duration:     item.Alive,
-				})
-		} else {
-			log.Printf("Auction() | Rejected auction '%v', '%v' is currently live\n", item.Name, lastAuction.item)
-			if DELAYED_MUTEX {
-				go r.DelayedUnlock()
-			} else {
-				r.mutex.Unlock()
-			}
-			return &DAS.Ack{
-				Response: DAS.Acks_FAIL,
-				Message:  "An auction is already running",
-			}, nil
-		}
+	defer r.mutex.Unlock()
+	// once anyone has joined or left, the membership in our log decides who the peers are
+	if r.epoch > 0 {
+		log.Printf("ConnectPeers() | Using membership epoch %v from the log, instead of the configured peers\n", r.epoch)
+		return
 	}
-	if DELAYED_MUTEX {
-		go r.DelayedUnlock()
-	} else {
-		r.mutex.Unlock()
+	for _, addr := range addrs {
+		r.AddPeer(addr)
 	}
-	return &DAS.Ack{
-		Response: DAS.Acks_SUCCESS,
-	}, nil
 }
 
-func (r *Replica) Ping(ctx context.Context, _ *DAS.Empty) (*DAS.Empty, error) {
-	return &DAS.Empty{}, nil
+// dials a replica without blocking, & starts replicating to it - returns nil if it is us, already a peer or could not be dialed.
+// has to be called while holding the mutex
+func (r *Replica) AddPeer(addr string) DAS.DASClient {
+	if _, ok := r.peers[addr]; ok || addr == r.addr {
+		return nil
+	}
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	if err != nil {
+		log.Printf("ConnectPeers() | Dial '%v' failed: %v\n", addr, err)
+		return nil
+	}
+	peer := DAS.NewDASClient(conn)
+	r.peers[addr] = peer
+	r.conns[addr] = conn
+	r.kick[addr] = make(chan struct{}, 1)
+	r.next[addr] = r.LastIndex() + 1
+	r.match[addr] = 0
+	go r.ReplicateTo(addr, peer)
+	return peer
 }
 
-func (r *Replica) DelayedUnlock() {
-	time.Sleep(5 * time.Millisecond)
-	r.mutex.Unlock()
+// disconnects from a replica that left the cluster, the goroutine replicating to it stops by itself.
+// has to be called while holding the mutex
+func (r *Replica) RemovePeer(addr string) {
+	if conn, ok := r.conns[addr]; ok {
+		conn.Close()
+	}
+	delete(r.peers, addr)
+	delete(r.conns, addr)
+	delete(r.kick, addr)
+	delete(r.next, addr)
+	delete(r.match, addr)
+	r.detector.Forget(addr)
 }
 
 // sets the logger to use a log.txt file instead of the console
-func setLog(port uint16) *os.File {
+func setLog(port uint16, precise bool) *os.File {
 	filename := fmt.Sprintf("replica-%v.txt", port)
 	// Clears the log.txt file when a new server is started
 	if err := os.Truncate(filename, 0); err != nil {
@@ -248,7 +280,7 @@ func setLog(port uint16) *os.File {
 	// print to both file and console
 	mw := io.MultiWriter(os.Stdout, f)
 
-	if PRECISE_LOGGING {
+	if precise {
 		log.SetFlags(log.LstdFlags | log.Lmicroseconds)
 	}
 	log.SetOutput(mw)
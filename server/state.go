@@ -0,0 +1,577 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"math"
+	"math/bits"
+	"time"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+)
+
+// State is the replicated state machine - it is only ever changed by applying committed log entries,
+// and since every replica applies the same entries in the same order, every replica ends up with the same auctions.
+// nothing in here may look at the local clock, the time an entry was appended by the leader is used instead
+type State struct {
+	auctions  []*Auction          // every auction, in the order they were started
+	byID      map[string]*Auction // the same auctions, by id
+	live      map[string]*Auction // auctions that have not been closed yet, by id
+	revealing map[string]*Auction // closed auctions that bids are still being revealed on, by id - see reveal.go
+	clock     uint64              // lamport clock of the last entry applied, see clock.go
+	members   []string            // every replica in the cluster, as of the last membership entry applied - see members.go
+	epoch     uint64              // epoch of that entry, 0 while noone has joined or left
+	requests  map[string]*request // ack of every keyed bid applied within DEDUPE_TTL, by key - see dedupe.go
+	order     []string            // the same keys, oldest first
+}
+
+type Auction struct {
+	id           string // uuid given to the auction by the leader that started it
+	highestBid   uint64
+	bidder       uint32
+	item         string
+	auctionStart time.Time
+	duration     uint32
+	extendWithin uint32 // a bid accepted this close to the end extends the auction by extendBy, see accept
+	extendBy     uint32
+	extended     uint32 // milliseconds the auction has been extended by in total
+	increment    uint64 // a bid has to be this much higher than the highest bid, or incrementPct percent of it - see Minimum
+	incrementPct uint32
+	reserve      uint64 // the highest bid has to be at least this for the item to sell, 0 is no reserve
+	format       DAS.Format
+	drop         uint64 // the price of a dutch auction drops by this every dropEvery milliseconds, see dutch.go
+	dropEvery    uint32
+	buyNow       uint64             // a bid of at least this wins the auction outright, 0 is no buy it now price - see buynow.go
+	revealFor    uint32             // milliseconds bids are revealed for once a sealed auction is over, 0 if it takes bids - see reveal.go
+	ended        time.Time          // set when a bid ended the auction before its time was up
+	proxies      map[uint32]*proxy  // maximum bid of each bidder that set one & can still bid, see proxy.go
+	commits      map[uint32]*commit // commitment of each bidder on a commit-reveal auction, see reveal.go
+	closed       bool               // set when the close entry for the auction has been applied
+	closedTime   time.Time          // the time the leader gave the close entry, zero if a bid ended the auction
+	bids         []*DAS.BidRecord   // every bid placed on the auction, in the order they were applied - see ledger.go
+
+	// lamport clocks of the entries that started the auction, placed the highest bid, closed it & ended the time to
+	// reveal bids on it - 0 if not yet
+	opened     uint64
+	clock      uint64
+	closedAt   uint64
+	revealedAt uint64
+}
+
+// how an auction is encoded in snapshots, kept apart from Auction so its fields can stay unexported
+type auctionRecord struct {
+	ID           string         `json:"id"`
+	HighestBid   uint64         `json:"highest_bid"`
+	Bidder       uint32         `json:"bidder"`
+	Item         string         `json:"item"`
+	AuctionStart int64          `json:"auction_start"` // unix milliseconds
+	Duration     uint32         `json:"duration"`
+	ExtendWithin uint32         `json:"extend_within"`
+	ExtendBy     uint32         `json:"extend_by"`
+	Extended     uint32         `json:"extended"`
+	Increment    uint64         `json:"increment"`
+	IncrementPct uint32         `json:"increment_percent"`
+	Reserve      uint64         `json:"reserve"`
+	Proxies      []proxyRecord  `json:"proxies"`
+	Format       DAS.Format     `json:"format"`
+	Drop         uint64         `json:"drop"`
+	DropEvery    uint32         `json:"drop_every"`
+	RevealFor    uint32         `json:"reveal_for"`
+	BuyNow       uint64         `json:"buy_now"`
+	Commits      []commitRecord `json:"commits"`
+	Ended        int64          `json:"ended"`       // unix milliseconds, 0 if it was not ended early
+	ClosedTime   int64          `json:"closed_time"` // unix milliseconds, 0 if it was not closed by a close entry
+	Closed       bool           `json:"closed"`
+	Opened       uint64         `json:"opened"`
+	Clock        uint64         `json:"clock"`
+	ClosedAt     uint64         `json:"closed_at"`
+	RevealedAt   uint64         `json:"revealed_at"`
+	Bids         []bidRecord    `json:"bids"`
+}
+
+func NewState() State {
+	return State{
+		byID:      make(map[string]*Auction),
+		live:      make(map[string]*Auction),
+		revealing: make(map[string]*Auction),
+		requests:  make(map[string]*request),
+	}
+}
+
+// how the state is encoded in snapshots, snapshots from before membership changes are only the list of auctions
+type stateRecord struct {
+	Auctions []auctionRecord `json:"auctions"`
+	Members  []string        `json:"members"`
+	Epoch    uint64          `json:"epoch"`
+	Requests []requestRecord `json:"requests"`
+	Clock    uint64          `json:"clock"` // 0 in snapshots from before it was saved
+}
+
+// Encode returns the state as it is stored in snapshots
+func (s *State) Encode() []byte {
+	records := make([]auctionRecord, len(s.auctions))
+	for i, a := range s.auctions {
+		records[i] = auctionRecord{
+			ID:           a.id,
+			HighestBid:   a.highestBid,
+			Bidder:       a.bidder,
+			Item:         a.item,
+			AuctionStart: a.auctionStart.UnixMilli(),
+			Duration:     a.duration,
+			ExtendWithin: a.extendWithin,
+			ExtendBy:     a.extendBy,
+			Extended:     a.extended,
+			Increment:    a.increment,
+			IncrementPct: a.incrementPct,
+			Reserve:      a.reserve,
+			Proxies:      encodeProxies(a.proxies),
+			Format:       a.format,
+			Drop:         a.drop,
+			DropEvery:    a.dropEvery,
+			RevealFor:    a.revealFor,
+			BuyNow:       a.buyNow,
+			Commits:      encodeCommits(a.commits),
+			Closed:       a.closed,
+			Opened:       a.opened,
+			Clock:        a.clock,
+			ClosedAt:     a.closedAt,
+			RevealedAt:   a.revealedAt,
+			Bids:         encodeBids(a.bids),
+		}
+		if !a.ended.IsZero() {
+			records[i].Ended = a.ended.UnixMilli()
+		}
+		if !a.closedTime.IsZero() {
+			records[i].ClosedTime = a.closedTime.UnixMilli()
+		}
+	}
+	data, err := json.Marshal(stateRecord{Auctions: records, Members: s.members, Epoch: s.epoch, Requests: s.encodeRequests(), Clock: s.clock})
+	if err != nil {
+		log.Fatalf("Failed to encode state: %v", err)
+	}
+	return data
+}
+
+// DecodeState restores a state encoded by Encode
+func DecodeState(data []byte) (State, error) {
+	var record stateRecord
+	if len(data) > 0 && data[0] == '[' {
+		if err := json.Unmarshal(data, &record.Auctions); err != nil {
+			return State{}, err
+		}
+	} else if err := json.Unmarshal(data, &record); err != nil {
+		return State{}, err
+	}
+	s := NewState()
+	s.members = record.Members
+	s.epoch = record.Epoch
+	s.clock = record.Clock
+	if err := s.decodeRequests(record.Requests); err != nil {
+		return State{}, err
+	}
+	for _, record := range record.Auctions {
+		auction := &Auction{
+			id:           record.ID,
+			highestBid:   record.HighestBid,
+			bidder:       record.Bidder,
+			item:         record.Item,
+			auctionStart: time.UnixMilli(record.AuctionStart),
+			duration:     record.Duration,
+			extendWithin: record.ExtendWithin,
+			extendBy:     record.ExtendBy,
+			extended:     record.Extended,
+			increment:    record.Increment,
+			incrementPct: record.IncrementPct,
+			reserve:      record.Reserve,
+			proxies:      decodeProxies(record.Proxies),
+			format:       record.Format,
+			drop:         record.Drop,
+			dropEvery:    record.DropEvery,
+			revealFor:    record.RevealFor,
+			buyNow:       record.BuyNow,
+			commits:      decodeCommits(record.Commits),
+			closed:       record.Closed,
+			opened:       record.Opened,
+			clock:        record.Clock,
+			closedAt:     record.ClosedAt,
+			revealedAt:   record.RevealedAt,
+			bids:         decodeBids(record.Bids),
+		}
+		if record.Ended != 0 {
+			auction.ended = time.UnixMilli(record.Ended)
+		}
+		if record.ClosedTime != 0 {
+			auction.closedTime = time.UnixMilli(record.ClosedTime)
+		}
+		s.add(auction)
+		// the clock saved is never behind an auction, only snapshots from before it was saved go by the last change to one
+		if changed := auction.Changed(); changed > s.clock {
+			s.clock = changed
+		}
+	}
+	return s, nil
+}
+
+// returns when the auction is over, unless it is extended again
+func (a *Auction) End() time.Time {
+	if !a.ended.IsZero() {
+		return a.ended
+	}
+	return a.auctionStart.Add(time.Duration(a.duration+a.extended) * time.Millisecond)
+}
+
+// returns whether the auction is over at the given time
+func (a *Auction) Over(now time.Time) bool {
+	return a.closed || !now.Before(a.End())
+}
+
+// returns the lowest bid that will be accepted, the highest bid plus the increment - & always more than the highest bid.
+// sealed bids never raise the highest bid, it stays the starting bid - which a sealed bid can be as low as
+func (a *Auction) Minimum() uint64 {
+	if a.Sealed() {
+		return a.highestBid
+	}
+	return a.Above(a.highestBid)
+}
+
+// returns the lowest bid that outbids amount, amount plus the increment - MaxUint64 if that does not fit
+func (a *Auction) Above(amount uint64) uint64 {
+	step := a.increment
+	// percent of the amount is rounded up, so the increment is never less than asked for. the product is 128 bits,
+	// so a percent of a huge amount cannot wrap around to a tiny increment
+	hi, lo := bits.Mul64(amount, uint64(a.incrementPct))
+	lo, carry := bits.Add64(lo, 99, 0)
+	hi += carry
+	pct := uint64(math.MaxUint64)
+	if hi < 100 {
+		pct, _ = bits.Div64(hi, lo, 100)
+	}
+	if pct > step {
+		step = pct
+	}
+	if step == 0 {
+		step = 1
+	}
+	above, carry := bits.Add64(amount, step, 0)
+	if carry != 0 {
+		return math.MaxUint64
+	}
+	return above
+}
+
+// returns whether the highest bid is at least the reserve, auctions without a reserve always meet it
+func (a *Auction) ReserveMet() bool {
+	bidder, amount, _ := a.Winner()
+	return bidder != 0 && amount >= a.reserve
+}
+
+// returns whether the auction is over at the given time, & the item was sold
+func (a *Auction) Sold(now time.Time) bool {
+	return a.Decided(now) && a.ReserveMet()
+}
+
+// returns the lamport clock of the last entry that changed the auction
+func (a *Auction) Changed() uint64 {
+	changed := a.clock
+	if a.closedAt > changed {
+		changed = a.closedAt
+	}
+	if a.revealedAt > changed {
+		changed = a.revealedAt
+	}
+	return changed
+}
+
+// returns the auction as it is sent to clients, left is 0 once it is over
+func (a *Auction) Outcome(now time.Time) *DAS.Outcome {
+	var left uint32
+	if !a.Over(now) {
+		left = uint32(a.End().Sub(now).Milliseconds())
+	}
+	outcome := &DAS.Outcome{
+		Left:    left,
+		Amount:  a.highestBid,
+		Bidder:  a.bidder,
+		Format:  a.format,
+		Item:    a.item,
+		Auction: a.id,
+		Clock:   a.Changed(),
+		Started: a.auctionStart.UnixMilli(),
+		Ends:    a.End().UnixMilli(),
+		Minimum: a.Minimum(),
+		Reserve: a.reserve > 0,
+		BuyNow:  a.buyNow,
+	}
+	if a.format == DAS.Format_DUTCH && !a.Over(now) {
+		outcome.ClockPrice = a.ClockPrice(now)
+		outcome.Minimum = outcome.ClockPrice
+	}
+	if a.CommitReveal() && a.Over(now) && !a.Decided(now) && now.Before(a.RevealEnd()) {
+		outcome.RevealLeft = uint32(a.RevealEnd().Sub(now).Milliseconds())
+	}
+	// whether the reserve is met is kept until the auction is over, so bidders cannot feel their way to it
+	if a.Decided(now) {
+		outcome.ReserveMet = a.ReserveMet()
+		var price uint64
+		outcome.Bidder, outcome.Amount, price = a.Winner()
+		if outcome.Bidder != 0 {
+			outcome.Price = price
+		}
+	}
+	return outcome
+}
+
+// returns the auction with the given id, an empty id is the last auction started - nil if there is no such auction
+func (s *State) Get(id string) *Auction {
+	if id == "" {
+		if len(s.auctions) == 0 {
+			return nil
+		}
+		return s.auctions[len(s.auctions)-1]
+	}
+	return s.byID[id]
+}
+
+func (s *State) add(auction *Auction) {
+	s.auctions = append(s.auctions, auction)
+	s.byID[auction.id] = auction
+	if !auction.closed {
+		s.live[auction.id] = auction
+	} else if auction.CommitReveal() && auction.revealedAt == 0 {
+		s.revealing[auction.id] = auction
+	}
+}
+
+// Apply applies a committed entry, & returns the outcome to send to the client that requested it
+func (s *State) Apply(entry *DAS.Entry) *DAS.Ack {
+	now := time.UnixMilli(entry.Time)
+	if entry.Clock > s.clock {
+		s.clock = entry.Clock
+	}
+	ack := &DAS.Ack{
+		Response: DAS.Acks_SUCCESS,
+	}
+	// a retry of a bid that was already applied, the client gets the ack it missed
+	if amount := Bidding(entry); amount != nil {
+		if seen := s.Seen(amount, now); seen != nil {
+			log.Printf("Apply() | Bid from %v with request '%v' was already applied at clock %v\n", amount.Id, amount.Request, seen.Clock)
+			return seen
+		}
+	}
+	if entry.Bid != nil {
+		ack = s.applyBid(entry.Bid, now, entry.Clock)
+		if ack.Response == DAS.Acks_SUCCESS {
+			s.answerBid(entry.Bid, ack, now, entry.Clock)
+		}
+		s.record(entry, ack)
+	} else if entry.MaxBid != nil {
+		ack = s.applyMaxBid(entry.MaxBid, now, entry.Clock)
+		s.record(entry, ack)
+	} else if entry.Commit != nil {
+		// only commitments & reveals that were taken go in the ledger, the rest hold nothing worth settling a dispute with
+		ack = s.applyCommit(entry.Commit, entry.Clock)
+		if ack.Response == DAS.Acks_SUCCESS {
+			s.record(entry, ack)
+		}
+	} else if entry.Reveal != nil {
+		ack = s.applyReveal(entry.Reveal, entry.Clock)
+		if ack.Response == DAS.Acks_SUCCESS {
+			s.record(entry, ack)
+		}
+	} else if entry.Item != nil {
+		ack = s.applyAuction(entry.Auction, entry.Item, now, entry.Clock)
+	} else if entry.Close != nil {
+		s.applyClose(entry.Close, now, entry.Clock)
+	} else if entry.RevealEnd != nil {
+		s.applyRevealEnd(entry.RevealEnd, now, entry.Clock)
+	} else if entry.Members != nil {
+		s.members = entry.Members.Members
+		s.epoch = entry.Members.Epoch
+		log.Printf("Apply() | Membership epoch %v committed, members: %v\n", s.epoch, s.members)
+	}
+	ack.Clock = entry.Clock
+	ack.Epoch = s.epoch
+	if amount := Bidding(entry); amount != nil {
+		s.remember(amount, ack, now)
+	}
+	return ack
+}
+
+// whether a bid is late is decided by the clocks alone - the leader appends the close of an auction before any bid
+// that reaches it after the auctions time is up, so a bid is late exactly when the close was applied before it
+func (s *State) applyBid(amount *DAS.Amount, now time.Time, clock uint64) *DAS.Ack {
+	auction, ack := s.biddable(amount, clock)
+	if auction == nil {
+		return ack
+	}
+	if auction.Sealed() {
+		return auction.seal(amount, clock)
+	} else if auction.format == DAS.Format_DUTCH {
+		return s.applyDutch(auction, amount, now, clock)
+	}
+	if minimum := auction.Minimum(); amount.Bid >= minimum {
+		log.Printf("Apply() | Accepted bid from %v on '%v' at clock %v (sent at %v), amount: %v\n", amount.Id, auction.id, clock, amount.Clock, amount.Bid)
+		auction.accept(amount.Id, amount.Bid, now, clock)
+		if auction.BuysNow(amount.Bid) {
+			return s.buyNow(auction, now, clock)
+		}
+		return &DAS.Ack{
+			Response: DAS.Acks_SUCCESS,
+			Message:  "Bid increased",
+			Auction:  auction.id,
+		}
+	}
+	// equal bids go to whoever has the lower clock, as they were ordered first
+	log.Printf("Apply() | Rejected bid from %v on '%v' at clock %v (sent at %v), amount: %v - highest bid is from clock %v, lowest bid accepted is %v\n", amount.Id, auction.id, clock, amount.Clock, amount.Bid, auction.clock, auction.Minimum())
+	if amount.Bid > auction.highestBid {
+		return auction.TooLow("Bid is not enough higher than the highest bid")
+	}
+	return auction.TooLow("Bid is lower than the highest bid")
+}
+
+// returns the auction a bid or maximum bid is placed on - or if it cannot be bid on, nil & the ack to answer with
+func (s *State) biddable(amount *DAS.Amount, clock uint64) (*Auction, *DAS.Ack) {
+	auction := s.Get(amount.Auction)
+	if auction == nil {
+		log.Printf("Apply() | Told %v, no auction '%v'\n", amount.Id, amount.Auction)
+		return nil, &DAS.Ack{
+			Response: DAS.Acks_EXCEPTION,
+			Message:  "No such auction to bid on",
+			Reason:   DAS.Reason_NO_AUCTION,
+			Auction:  amount.Auction,
+		}
+	}
+	if auction.closed {
+		log.Printf("Apply() | Told %v, auction '%v' is over - bid at clock %v (sent at %v) is after the close at clock %v\n", amount.Id, auction.id, clock, amount.Clock, auction.closedAt)
+		return nil, &DAS.Ack{
+			Response: DAS.Acks_EXCEPTION,
+			Message:  "Auction is over",
+			Auction:  auction.id,
+			Reason:   DAS.Reason_AUCTION_CLOSED,
+		}
+	}
+	return auction, nil
+}
+
+// makes amount by bidder the highest bid. a bid accepted within extendWithin of the end extends the auction,
+// going by the time the leader appended it - so every replica extends it the same
+func (a *Auction) accept(bidder uint32, amount uint64, now time.Time, clock uint64) {
+	a.bidder = bidder
+	a.highestBid = amount
+	a.clock = clock
+	if a.extendBy > 0 && a.End().Sub(now) < time.Duration(a.extendWithin)*time.Millisecond {
+		a.extended += a.extendBy
+		log.Printf("Apply() | Extended auction '%v' by %vms, it is over at %v\n", a.id, a.extendBy, a.End().Format("15:04:05.000"))
+	}
+}
+
+// returns the ack for a bid that was not enough to become the highest bid
+func (a *Auction) TooLow(message string) *DAS.Ack {
+	return &DAS.Ack{
+		Response: DAS.Acks_FAIL,
+		Message:  message,
+		Auction:  a.id,
+		Reason:   DAS.Reason_BID_TOO_LOW,
+		Highest:  a.highestBid,
+		Bidder:   a.bidder,
+		Minimum:  a.Minimum(),
+	}
+}
+
+func (s *State) applyAuction(id string, item *DAS.Item, now time.Time, clock uint64) *DAS.Ack {
+	if _, exists := s.byID[id]; exists || id == "" {
+		log.Printf("Apply() | Rejected auction '%v', id '%v' is not unique\n", item.Name, id)
+		return &DAS.Ack{
+			Response: DAS.Acks_EXCEPTION,
+			Message:  "Auction id is not unique",
+			Reason:   DAS.Reason_NOT_UNIQUE,
+			Auction:  id,
+		}
+	}
+	if item.BuyNow > 0 && item.Format != DAS.Format_OPEN {
+		log.Printf("Apply() | Rejected auction '%v', a %v auction has no buy it now price\n", item.Name, item.Format)
+		return &DAS.Ack{
+			Response: DAS.Acks_EXCEPTION,
+			Message:  "Only open auctions can have a buy it now price",
+			Reason:   DAS.Reason_NOT_SUPPORTED,
+			Auction:  id,
+		}
+	}
+	message := CheckDutch(item)
+	if message == "" {
+		message = CheckBuyNow(item)
+	}
+	if message != "" {
+		log.Printf("Apply() | Rejected auction '%v' - %v\n", item.Name, message)
+		return &DAS.Ack{
+			Response: DAS.Acks_FAIL,
+			Message:  message,
+			Reason:   DAS.Reason_BAD_AUCTION,
+			Auction:  id,
+		}
+	}
+	log.Printf("Apply() | Started auction '%v' for '%v' at clock %v (sent at %v), duration: %v\n", id, item.Name, clock, item.Clock, item.Alive)
+	s.add(&Auction{
+		id:           id,
+		highestBid:   item.Start,
+		bidder:       0,
+		item:         item.Name,
+		auctionStart: now,
+		duration:     item.Alive,
+		extendWithin: item.ExtendWithin,
+		extendBy:     item.ExtendBy,
+		increment:    item.Increment,
+		incrementPct: item.IncrementPercent,
+		reserve:      item.Reserve,
+		format:       item.Format,
+		drop:         item.Drop,
+		dropEvery:    item.DropEvery,
+		revealFor:    item.RevealFor,
+		buyNow:       item.BuyNow,
+		opened:       clock,
+		clock:        clock,
+	})
+	return &DAS.Ack{
+		Response: DAS.Acks_SUCCESS,
+		Auction:  id,
+	}
+}
+
+// the leader appends the close once the auction is over by the bids it has applied - a bid appended before the close
+// might still extend it, in which case the close is ignored & the leader appends another once the extension is up
+func (s *State) applyClose(close *DAS.Close, now time.Time, clock uint64) {
+	auction, ok := s.live[close.Auction]
+	if !ok {
+		return
+	}
+	if now.Before(auction.End()) {
+		log.Printf("Apply() | Ignored close of auction '%v' at clock %v, it was extended until %v\n", auction.id, clock, auction.End().Format("15:04:05.000"))
+		return
+	}
+	auction.closedTime = now
+	s.end(auction, time.Time{}, clock)
+}
+
+// closes an auction, when its close is applied - or when a bid ends it before its time is up, then ended is when
+func (s *State) end(auction *Auction, ended time.Time, clock uint64) {
+	auction.closed = true
+	auction.closedAt = clock
+	auction.ended = ended
+	delete(s.live, auction.id)
+	if auction.CommitReveal() {
+		s.revealing[auction.id] = auction
+		log.Printf("Apply() | Closed auction '%v' for '%s' at clock %v, bids are revealed until %v\n", auction.id, auction.item, clock, auction.RevealEnd().Format("15:04:05.000"))
+		return
+	}
+	auction.logWinner("Closed", clock)
+}
+
+// logs who won the auction, once it is known - what says what the entry at clock did, Closed or Decided
+func (a *Auction) logWinner(what string, clock uint64) {
+	bidder, amount, price := a.Winner()
+	if !a.ReserveMet() {
+		log.Printf("Apply() | %v auction '%v' for '%s' at clock %v, not sold - highest bid (by id %v) of %v did not meet the reserve\n", what, a.id, a.item, clock, bidder, amount)
+		return
+	}
+	log.Printf("Apply() | %v auction '%v' for '%s' at clock %v, won by id %v for %v - paying %v\n", what, a.id, a.item, clock, bidder, amount, price)
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/LocatedInSpace/Distributed-Auction-System/commitment"
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+)
+
+// when the entries in the tests are appended, ms is milliseconds after it
+var epoch = time.UnixMilli(1_000_000)
+
+// returns the entry as the leader would have appended it, at the given clock & ms milliseconds after epoch
+func at(clock uint64, ms int64, entry *DAS.Entry) *DAS.Entry {
+	entry.Clock = clock
+	entry.Time = epoch.Add(time.Duration(ms) * time.Millisecond).UnixMilli()
+	return entry
+}
+
+func TestAbove(t *testing.T) {
+	tests := []struct {
+		name      string
+		increment uint64
+		pct       uint32
+		amount    uint64
+		above     uint64
+	}{
+		{"no increment is 1", 0, 0, 100, 101},
+		{"increment", 5, 0, 100, 105},
+		{"percent", 0, 10, 100, 110},
+		{"percent is rounded up", 0, 10, 101, 112},
+		{"the larger of the two", 5, 10, 20, 25},
+		{"percent of 0 is still 1", 0, 10, 0, 1},
+		{"percent of a huge amount does not wrap around", 0, 100, 1 << 60, 1 << 61},
+		{"percent past the largest bid", 0, 300, math.MaxUint64 / 2, math.MaxUint64},
+		{"increment past the largest bid", 5, 0, math.MaxUint64 - 2, math.MaxUint64},
+	}
+	for _, test := range tests {
+		a := &Auction{increment: test.increment, incrementPct: test.pct}
+		if above := a.Above(test.amount); above != test.above {
+			t.Errorf("%v: Above(%v) = %v, want %v", test.name, test.amount, above, test.above)
+		}
+	}
+}
+
+func TestMinimum(t *testing.T) {
+	tests := []struct {
+		name    string
+		highest uint64
+		pct     uint32
+		minimum uint64
+	}{
+		{"the starting bid has to be outbid", 10, 0, 15},
+		{"the highest bid has to be outbid", 100, 30, 130},
+	}
+	for _, test := range tests {
+		a := &Auction{highestBid: test.highest, bidder: 1, increment: 5, incrementPct: test.pct}
+		if minimum := a.Minimum(); minimum != test.minimum {
+			t.Errorf("%v: Minimum() = %v, want %v", test.name, minimum, test.minimum)
+		}
+	}
+}
+
+func TestEncodeDecodeState(t *testing.T) {
+	s := NewState()
+	entries := []*DAS.Entry{
+		at(1, 0, &DAS.Entry{Members: &DAS.Membership{Members: []string{"a:1", "b:2"}, Epoch: 1}}),
+		at(2, 0, &DAS.Entry{Auction: "open", Item: &DAS.Item{Name: "open", Start: 10, Alive: 1000, ExtendWithin: 100, ExtendBy: 200, Increment: 5, Reserve: 50, BuyNow: 500}}),
+		at(3, 0, &DAS.Entry{Auction: "sealed", Item: &DAS.Item{Name: "sealed", Start: 10, Alive: 1000, Format: DAS.Format_SECOND_PRICE}}),
+		at(4, 0, &DAS.Entry{Auction: "reveal", Item: &DAS.Item{Name: "reveal", Start: 10, Alive: 1000, Format: DAS.Format_FIRST_PRICE, RevealFor: 1000}}),
+		at(5, 0, &DAS.Entry{Auction: "dutch", Item: &DAS.Item{Name: "dutch", Start: 100, Alive: 1000, Format: DAS.Format_DUTCH, Drop: 10, DropEvery: 100}}),
+		at(6, 10, &DAS.Entry{Bid: &DAS.Amount{Id: 1, Bid: 20, Auction: "open", Request: "one"}}),
+		at(7, 20, &DAS.Entry{MaxBid: &DAS.Amount{Id: 2, Bid: 40, Auction: "open"}}),
+		at(8, 30, &DAS.Entry{Bid: &DAS.Amount{Id: 1, Bid: 15, Auction: "sealed"}}),
+		at(9, 40, &DAS.Entry{Commit: &DAS.Amount{Id: 1, Auction: "reveal", Commitment: commitment.Hash("reveal", 1, 30, []byte("nonce"))}}),
+		at(10, 1000, &DAS.Entry{Close: &DAS.Close{Auction: "reveal"}}),
+		at(11, 1010, &DAS.Entry{Reveal: &DAS.Amount{Id: 1, Bid: 30, Auction: "reveal", Nonce: []byte("nonce")}}),
+		// changes no auction, so the clock is ahead of the last change to any of them
+		at(12, 1020, &DAS.Entry{Members: &DAS.Membership{Members: []string{"a:1", "b:2", "c:3"}, Epoch: 2}}),
+	}
+	for _, entry := range entries {
+		if ack := s.Apply(entry); ack.Response != DAS.Acks_SUCCESS {
+			t.Fatalf("Apply(%v) = %v", entry, ack)
+		}
+	}
+	data := s.Encode()
+	decoded, err := DecodeState(data)
+	if err != nil {
+		t.Fatalf("DecodeState() failed: %v", err)
+	}
+	if decoded.clock != s.clock {
+		t.Errorf("clock after DecodeState() = %v, want %v", decoded.clock, s.clock)
+	}
+	if again := decoded.Encode(); !bytes.Equal(again, data) {
+		t.Errorf("state changed in the round trip:\n%s\n%s", data, again)
+	}
+	now := epoch.Add(500 * time.Millisecond)
+	for _, id := range []string{"open", "sealed", "reveal", "dutch"} {
+		if want, got := s.Get(id).Outcome(now).String(), decoded.Get(id).Outcome(now).String(); got != want {
+			t.Errorf("Outcome() of '%v' = %v, want %v", id, got, want)
+		}
+	}
+	// a retry of the keyed bid still gets the ack it was answered with
+	if seen := decoded.Seen(&DAS.Amount{Id: 1, Request: "one"}, now); seen == nil || seen.Clock != 6 {
+		t.Errorf("Seen() = %v, want the ack from clock 6", seen)
+	}
+	// & an entry after the snapshot does not go back in time
+	if ack := decoded.Apply(at(13, 50, &DAS.Entry{Bid: &DAS.Amount{Id: 3, Bid: 100, Auction: "open"}})); ack.Response != DAS.Acks_SUCCESS {
+		t.Errorf("Apply() after DecodeState() = %v", ack)
+	}
+}
+
+func TestSoftClose(t *testing.T) {
+	s := NewState()
+	s.Apply(at(1, 0, &DAS.Entry{Auction: "a", Item: &DAS.Item{Name: "a", Start: 10, Alive: 1000, ExtendWithin: 100, ExtendBy: 200}}))
+	a := s.Get("a")
+	steps := []struct {
+		name   string
+		entry  *DAS.Entry
+		end    int64 // milliseconds after epoch the auction is over at, after the entry
+		closed bool
+	}{
+		{"a bid before the last 100ms does not extend", at(2, 500, &DAS.Entry{Bid: &DAS.Amount{Id: 1, Bid: 20, Auction: "a"}}), 1000, false},
+		{"a bid in the last 100ms does", at(3, 950, &DAS.Entry{Bid: &DAS.Amount{Id: 2, Bid: 30, Auction: "a"}}), 1200, false},
+		{"the close appended before the bid is ignored", at(4, 1000, &DAS.Entry{Close: &DAS.Close{Auction: "a"}}), 1200, false},
+		{"a bid in the extension extends it again", at(5, 1150, &DAS.Entry{Bid: &DAS.Amount{Id: 1, Bid: 40, Auction: "a"}}), 1400, false},
+		{"a close once the extension is up is not", at(6, 1400, &DAS.Entry{Close: &DAS.Close{Auction: "a"}}), 1400, true},
+	}
+	for _, step := range steps {
+		s.Apply(step.entry)
+		if end := a.End().Sub(epoch).Milliseconds(); end != step.end || a.closed != step.closed {
+			t.Errorf("%v: over at %vms, closed %v - want %vms, %v", step.name, end, a.closed, step.end, step.closed)
+		}
+	}
+	if ack := s.Apply(at(7, 1410, &DAS.Entry{Bid: &DAS.Amount{Id: 2, Bid: 100, Auction: "a"}})); ack.Reason != DAS.Reason_AUCTION_CLOSED {
+		t.Errorf("Apply() of a bid after the close = %v, want %v", ack, DAS.Reason_AUCTION_CLOSED)
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// a replica that starts on the next free port knows nothing about the live auction, & raft would only bring it up to
+// date entry by entry once the leader gets to it. so before taking part it pulls the leaders state in one go.
+// the state has every maximum bid, reserve & sealed bid in it - so it never goes back to whoever asked, the leader sends
+// it to the address of the member that asked with InstallSnapshot, the same as to a follower that fell behind. a replica
+// that is joining the cluster is brought up to date by raft, once it has joined
+
+// StateTransfer is answered by the leader, once it has sent the member a snapshot of everything it has applied
+func (r *Replica) StateTransfer(ctx context.Context, member *DAS.Member) (*DAS.Transfer, error) {
+	if !r.ReadIndex() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		return &DAS.Transfer{Leader: r.leader}, nil
+	}
+	r.mutex.Lock()
+	peer, ok := r.peers[member.Addr]
+	if !ok || !r.IsMember(member.Addr) {
+		r.mutex.Unlock()
+		log.Printf("StateTransfer() | Turned down '%v', it is not in the cluster\n", member.Addr)
+		return nil, status.Errorf(codes.PermissionDenied, "'%v' is not in the cluster", member.Addr)
+	}
+	snapshot := &DAS.Snapshot{
+		Term:      r.term,
+		Leader:    r.addr,
+		LastIndex: r.applied,
+		LastTerm:  r.Term(r.applied),
+		State:     r.state.Encode(),
+	}
+	transfer := &DAS.Transfer{
+		Snapshot: &DAS.Snapshot{
+			Term:      snapshot.Term,
+			Leader:    snapshot.Leader,
+			LastIndex: snapshot.LastIndex,
+			LastTerm:  snapshot.LastTerm,
+		},
+	}
+	now := time.Now()
+	for _, auction := range r.state.live {
+		transfer.Live = append(transfer.Live, auction.Outcome(now))
+	}
+	// the member is up, since it asked - so we do not wait out the backoff from when it was down
+	r.conns[member.Addr].ResetConnectBackoff()
+	if !r.SendSnapshot(member.Addr, peer, snapshot) {
+		log.Printf("StateTransfer() | '%v' did not install the state\n", member.Addr)
+		return nil, status.Errorf(codes.Unavailable, "'%v' did not install the state", member.Addr)
+	}
+	log.Printf("StateTransfer() | Sent state up to entry %v to '%v'\n", snapshot.LastIndex, member.Addr)
+	return transfer, nil
+}
+
+// asks the peers for the leaders state, which the leader sends us with InstallSnapshot - it is only installed if it is
+// newer than what we recovered from disk. if noone answers, we are (re)starting together with the rest of the cluster -
+// & there is nothing to catch up on
+func (r *Replica) CatchUp() {
+	for addr, peer := range r.peers {
+		transfer := r.RequestTransfer(addr, peer)
+		// the peer was a follower, so we ask the leader it told us about
+		if transfer != nil && transfer.Leader != "" {
+			leader, ok := r.peers[transfer.Leader]
+			if !ok {
+				continue
+			}
+			transfer = r.RequestTransfer(transfer.Leader, leader)
+		}
+		if transfer == nil || transfer.Snapshot == nil {
+			continue
+		}
+		log.Printf("CatchUp() | Caught up to entry %v, %v auctions are live\n", transfer.Snapshot.LastIndex, len(transfer.Live))
+		for _, live := range transfer.Live {
+			log.Printf("CatchUp() | Auction '%v' for '%s' has %vms left, highest bid (by id %v) is %v\n", live.Auction, live.Item, live.Left, live.Bidder, live.Amount)
+		}
+		return
+	}
+	log.Printf("CatchUp() | No leader could transfer its state, starting with what was recovered\n")
+}
+
+func (r *Replica) RequestTransfer(addr string, peer DAS.DASClient) *DAS.Transfer {
+	ctx, cancel := context.WithTimeout(context.Background(), CATCHUP_TIMEOUT*time.Millisecond)
+	defer cancel()
+	transfer, err := peer.StateTransfer(ctx, &DAS.Member{Addr: r.addr})
+	if err != nil {
+		log.Printf("CatchUp() | '%v' could not transfer its state: %v\n", addr, err)
+		return nil
+	}
+	return transfer
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// everything raft needs to survive a crash (term, vote & log entries) is appended to a write-ahead log,
+// & synced to disk before the replica answers anyone. every SNAPSHOT_EVERY applied entries the state is saved
+// as a snapshot, & the entries it includes are dropped from both the log & the write-ahead log
+
+// WAL is the append-only file of a replica, replica-<port>.wal - next to it is the snapshot, replica-<port>.snap
+type WAL struct {
+	path     string
+	snapPath string
+	file     *os.File
+}
+
+// Record is one line of the write-ahead log, either the term & vote - or an entry & its index
+type Record struct {
+	Term     uint64          `json:"term,omitempty"`
+	VotedFor string          `json:"voted_for,omitempty"`
+	Index    uint64          `json:"index,omitempty"`
+	Entry    json.RawMessage `json:"entry,omitempty"`
+}
+
+func OpenWAL(port uint16) *WAL {
+	w := &WAL{
+		path:     fmt.Sprintf("replica-%v.wal", port),
+		snapPath: fmt.Sprintf("replica-%v.snap", port),
+	}
+	f, err := os.OpenFile(w.path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0666)
+	if err != nil {
+		log.Fatalf("Error opening write-ahead log: %v", err)
+	}
+	w.file = f
+	return w
+}
+
+// appends records & syncs them to disk, a replica that cannot persist cannot safely take part in raft - so it stops
+func (w *WAL) Append(records ...Record) {
+	writer := bufio.NewWriter(w.file)
+	for _, record := range records {
+		line, err := json.Marshal(record)
+		if err != nil {
+			log.Fatalf("Failed to encode write-ahead log record: %v", err)
+		}
+		writer.Write(line)
+		writer.WriteByte('\n')
+	}
+	if err := writer.Flush(); err != nil {
+		log.Fatalf("Failed to write to write-ahead log: %v", err)
+	}
+	if err := w.file.Sync(); err != nil {
+		log.Fatalf("Failed to sync write-ahead log: %v", err)
+	}
+}
+
+// replaces the write-ahead log with only the given records, used after compacting the log into a snapshot
+func (w *WAL) Rewrite(records []Record) {
+	tmp := w.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
+	if err != nil {
+		log.Fatalf("Error creating write-ahead log: %v", err)
+	}
+	w.file.Close()
+	w.file = f
+	w.Append(records...)
+	if err := os.Rename(tmp, w.path); err != nil {
+		log.Fatalf("Failed to replace write-ahead log: %v", err)
+	}
+}
+
+// reads every record in the write-ahead log, a torn last line (crash in the middle of a write) is ignored
+func (w *WAL) Records() []Record {
+	var records []Record
+	if _, err := w.file.Seek(0, 0); err != nil {
+		log.Fatalf("Failed to read write-ahead log: %v", err)
+	}
+	scanner := bufio.NewScanner(w.file)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			log.Printf("Records() | Ignoring unreadable record: %v\n", err)
+			break
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func (w *WAL) SaveSnapshot(snapshot *DAS.Snapshot) {
+	data, err := proto.Marshal(snapshot)
+	if err != nil {
+		log.Fatalf("Failed to encode snapshot: %v", err)
+	}
+	tmp := w.snapPath + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
+	if err != nil {
+		log.Fatalf("Error creating snapshot: %v", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		log.Fatalf("Failed to write snapshot: %v", err)
+	}
+	if err := f.Sync(); err != nil {
+		log.Fatalf("Failed to sync snapshot: %v", err)
+	}
+	f.Close()
+	if err := os.Rename(tmp, w.snapPath); err != nil {
+		log.Fatalf("Failed to replace snapshot: %v", err)
+	}
+}
+
+// returns the saved snapshot, nil if there is none
+func (w *WAL) LoadSnapshot() *DAS.Snapshot {
+	data, err := os.ReadFile(w.snapPath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		log.Fatalf("Failed to read snapshot: %v", err)
+	}
+	snapshot := &DAS.Snapshot{}
+	if err := proto.Unmarshal(data, snapshot); err != nil {
+		log.Fatalf("Failed to decode snapshot: %v", err)
+	}
+	return snapshot
+}
+
+func EntryRecord(index uint64, entry *DAS.Entry) Record {
+	data, err := protojson.Marshal(entry)
+	if err != nil {
+		log.Fatalf("Failed to encode entry: %v", err)
+	}
+	return Record{Index: index, Entry: data}
+}
+
+// persists term & vote - has to be called while holding the mutex, whenever either changes
+func (r *Replica) PersistState() {
+	r.wal.Append(Record{Term: r.term, VotedFor: r.votedFor})
+}
+
+// persists the entries from index to the end of the log - has to be called while holding the mutex
+func (r *Replica) PersistEntries(from uint64) {
+	var records []Record
+	for index := from; index <= r.LastIndex(); index++ {
+		records = append(records, EntryRecord(index, r.Entry(index)))
+	}
+	r.wal.Append(records...)
+}
+
+// restores the replica from its snapshot & write-ahead log, has to be called before it starts serving
+func (r *Replica) Recover() {
+	if snapshot := r.wal.LoadSnapshot(); snapshot != nil {
+		r.InstallState(snapshot)
+		log.Printf("Recover() | Loaded snapshot up to entry %v, %v auctions\n", snapshot.LastIndex, len(r.state.auctions))
+	}
+	entries := 0
+	for _, record := range r.wal.Records() {
+		if record.Entry == nil {
+			r.term = record.Term
+			r.votedFor = record.VotedFor
+			continue
+		}
+		if record.Index <= r.base {
+			continue
+		}
+		entry := &DAS.Entry{}
+		if err := protojson.Unmarshal(record.Entry, entry); err != nil {
+			log.Fatalf("Failed to decode entry %v: %v", record.Index, err)
+		}
+		// an entry overwriting an index, means the ones after it were replaced by a leader
+		if record.Index <= r.LastIndex() {
+			r.log = r.log[:record.Index-r.base]
+		}
+		r.log = append(r.log, entry)
+		entries++
+	}
+	r.WitnessLog()
+	r.UpdatePeers()
+	log.Printf("Recover() | Replayed term %v, %v entries - they are applied once the leader tells us they are committed\n", r.term, entries)
+}
+
+// saves the state as a snapshot, & drops every applied entry from the log - has to be called while holding the mutex
+func (r *Replica) TakeSnapshot() {
+	snapshot := &DAS.Snapshot{
+		LastIndex: r.applied,
+		LastTerm:  r.Term(r.applied),
+		State:     r.state.Encode(),
+	}
+	r.wal.SaveSnapshot(snapshot)
+	r.Compact(r.applied, snapshot.LastTerm)
+	r.snapshot = snapshot
+	log.Printf("TakeSnapshot() | Snapshot taken up to entry %v\n", snapshot.LastIndex)
+}
+
+// drops every entry up to & including index from the log - the entry at index becomes the new log[0]
+func (r *Replica) Compact(index uint64, term uint64) {
+	if index <= r.LastIndex() && r.Term(index) == term {
+		r.log = append([]*DAS.Entry{{Term: term}}, r.log[index-r.base+1:]...)
+	} else {
+		r.log = []*DAS.Entry{{Term: term}}
+	}
+	r.base = index
+	r.RewriteWAL()
+}
+
+// rewrites the write-ahead log to only hold term, vote & the entries after the snapshot - has to be called while holding the mutex
+func (r *Replica) RewriteWAL() {
+	records := []Record{{Term: r.term, VotedFor: r.votedFor}}
+	for index := r.base + 1; index <= r.LastIndex(); index++ {
+		records = append(records, EntryRecord(index, r.Entry(index)))
+	}
+	r.wal.Rewrite(records)
+}
+
+// replaces the state with the one in the snapshot - has to be called while holding the mutex
+func (r *Replica) InstallState(snapshot *DAS.Snapshot) {
+	state, err := DecodeState(snapshot.State)
+	if err != nil {
+		log.Fatalf("Failed to decode snapshot state: %v", err)
+	}
+	r.state = state
+	r.log = []*DAS.Entry{{Term: snapshot.LastTerm}}
+	r.base = snapshot.LastIndex
+	r.commit = snapshot.LastIndex
+	r.applied = snapshot.LastIndex
+	r.snapshot = &DAS.Snapshot{
+		LastIndex: snapshot.LastIndex,
+		LastTerm:  snapshot.LastTerm,
+		State:     snapshot.State,
+	}
+	r.WitnessLog()
+}
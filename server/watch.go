@@ -0,0 +1,114 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	DAS "github.com/LocatedInSpace/Distributed-Auction-System/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// any replica can be watched, since every replica applies the same entries - a follower just sees them
+// a few milliseconds after the leader does
+
+// returns the event telling watchers an auction is over - REVEAL while the winner is not known yet, then CLOSE
+func Closed(auction *Auction, now time.Time) DAS.Events {
+	if !auction.Decided(now) {
+		return DAS.Events_REVEAL
+	}
+	return DAS.Events_CLOSE
+}
+
+// WatchAuction streams the auction to the client, until the winner is known or the client hangs up
+func (r *Replica) WatchAuction(query *DAS.Query, stream DAS.DAS_WatchAuctionServer) error {
+	r.mutex.Lock()
+	auction := r.state.Get(query.Auction)
+	if auction == nil {
+		r.mutex.Unlock()
+		log.Printf("WatchAuction() | Told client that there is no auction '%v'\n", query.Auction)
+		return status.Errorf(codes.NotFound, "No auction '%v'", query.Auction)
+	}
+	id := auction.id
+	now := time.Now()
+	first := &DAS.Event{Kind: DAS.Events_TICK, Outcome: auction.Outcome(now)}
+	if auction.closed {
+		first.Kind = Closed(auction, now)
+	}
+	events := make(chan *DAS.Event, WATCH_BUFFER)
+	if first.Kind != DAS.Events_CLOSE {
+		if r.watchers[id] == nil {
+			r.watchers[id] = make(map[chan *DAS.Event]bool)
+		}
+		r.watchers[id][events] = true
+	}
+	r.mutex.Unlock()
+	log.Printf("WatchAuction() | Client started watching '%v'\n", id)
+
+	defer func() {
+		r.mutex.Lock()
+		delete(r.watchers[id], events)
+		if len(r.watchers[id]) == 0 {
+			delete(r.watchers, id)
+		}
+		r.mutex.Unlock()
+	}()
+
+	if err := stream.Send(first); err != nil || first.Kind == DAS.Events_CLOSE {
+		return err
+	}
+
+	ticker := time.NewTicker(WATCH_TICK * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		var event *DAS.Event
+		select {
+		case event = <-events:
+		case <-ticker.C:
+			r.mutex.Lock()
+			auction := r.state.Get(id)
+			now := time.Now()
+			event = &DAS.Event{Kind: DAS.Events_TICK, Outcome: auction.Outcome(now)}
+			// in case the close event was dropped
+			if auction.closed {
+				event.Kind = Closed(auction, now)
+			}
+			r.mutex.Unlock()
+		case <-stream.Context().Done():
+			log.Printf("WatchAuction() | Client stopped watching '%v'\n", id)
+			return nil
+		}
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+		if event.Kind == DAS.Events_CLOSE {
+			return nil
+		}
+	}
+}
+
+// pushes an event about an auction to everyone watching it - has to be called while holding the mutex
+func (r *Replica) Notify(kind DAS.Events, id string) {
+	watchers := r.watchers[id]
+	if len(watchers) == 0 {
+		return
+	}
+	auction := r.state.Get(id)
+	event := &DAS.Event{Kind: kind, Outcome: auction.Outcome(time.Now())}
+	for events := range watchers {
+		select {
+		case events <- event:
+		default:
+			log.Printf("Notify() | Dropped event for a watcher of '%v', it is not keeping up\n", id)
+		}
+	}
+	// nothing more will happen to the auction, so the watchers are not needed anymore
+	if kind == DAS.Events_CLOSE {
+		delete(r.watchers, id)
+	}
+}
+
+// tells the watchers of an auction that was just closed, see Closed - has to be called while holding the mutex
+func (r *Replica) NotifyClosed(id string) {
+	r.Notify(Closed(r.state.Get(id), time.Now()), id)
+}